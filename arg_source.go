@@ -7,9 +7,20 @@ import (
 	"github.com/xitonix/flags/core"
 )
 
+// helpCommand is the command which can be used to request the help page of a single flag (i.e. tool help port).
+const helpCommand = "help"
+
 type argSource struct {
-	arguments map[string]string
-	repeats   map[string]int
+	arguments        map[string]string
+	repeats          map[string]int
+	versionRequested bool
+	// helpTopic is the name of the flag whose help page has been requested (i.e. --help=port or help port).
+	helpTopic string
+	// revealHidden is true if the help output has been requested using --help-all (or --help-all=name).
+	revealHidden bool
+	// completionWords is not nil if the tool has been executed to provide shell completion candidates
+	// (i.e. tool __complete --flag prefix).
+	completionWords []string
 }
 
 type argSection struct {
@@ -19,6 +30,14 @@ type argSection struct {
 
 // creates a new command line argument parser and returns true if one of the arguments is
 // --help or -h
+//
+// The help page of a single flag can be requested using --help=name, -h=name or the help command (i.e. tool help name).
+// The hidden flags will also be included in the help output if it has been requested using --help-all, and their help
+// pages can only be requested using --help-all=name.
+//
+// The parser also records whether --version or -V has been requested. If the first argument is
+// the hidden __complete command, the rest of the arguments will be treated as the words which need
+// to be completed by the shell, instead of flags.
 func newArgSource(args []string) (*argSource, bool) {
 	src := &argSource{
 		arguments: make(map[string]string),
@@ -27,15 +46,39 @@ func newArgSource(args []string) (*argSource, bool) {
 	if len(args) == 0 {
 		return src, false
 	}
+	if args[0] == completeCommand {
+		src.completionWords = args[1:]
+		return src, false
+	}
 	var prevKey string
 	var isHelpRequested bool
+	if args[0] == helpCommand {
+		isHelpRequested = true
+		args = args[1:]
+		if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+			src.helpTopic = strings.TrimSpace(args[0])
+			args = args[1:]
+		}
+	}
 	for _, arg := range args {
 		number := regexp.MustCompile(`^[+-]?([0-9]*[.])?[0-9]+$`)
-		isKey := strings.HasPrefix(arg, "-") && !number.Match([]byte(arg))
+		// A single dash is a value by convention, which usually refers to the standard input (i.e. --payload -).
+		isKey := strings.HasPrefix(arg, "-") && arg != core.StdinValue && !number.Match([]byte(arg))
 		if !isHelpRequested && isKey {
 			ag := strings.TrimSpace(strings.ToLower(arg))
-			if ag == "--help" || ag == "-h" || strings.HasPrefix(ag, "-h=") || strings.HasPrefix(ag, "--help=") {
+			if ag == "--help" || ag == "-h" || ag == "--help-all" {
 				isHelpRequested = true
+				src.revealHidden = ag == "--help-all"
+				continue
+			}
+			if strings.HasPrefix(ag, "-h=") || strings.HasPrefix(ag, "--help=") || strings.HasPrefix(ag, "--help-all=") {
+				isHelpRequested = true
+				src.revealHidden = strings.HasPrefix(ag, "--help-all=")
+				// Boolean values are kept for backward compatibility (i.e. --help=true prints the full help).
+				topic := strings.TrimSpace(arg[strings.Index(arg, "=")+1:])
+				if t := strings.ToLower(topic); t != "true" && t != "false" {
+					src.helpTopic = topic
+				}
 				continue
 			}
 		}
@@ -81,6 +124,13 @@ func newArgSource(args []string) (*argSource, bool) {
 		src.arguments[prevKey] = arg
 		prevKey = ""
 	}
+
+	// Unlike help, the version flags are not removed from the arguments, since they
+	// are only reserved if the built-in version flag has been enabled on the bucket.
+	_, long := src.arguments["--version"]
+	_, short := src.arguments["-V"]
+	src.versionRequested = long || short
+
 	return src, isHelpRequested
 }
 
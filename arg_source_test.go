@@ -1,6 +1,7 @@
 package flags
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/xitonix/flags/mocks"
@@ -479,6 +480,23 @@ func TestArgSource_Read_With_Special_Values(t *testing.T) {
 			},
 			expectedCount: 1,
 		},
+		{
+			title: "single dash value",
+			in:    []string{"--key1", "-", "-k", "-"},
+			expected: []entry{
+				{
+					key:   "--key1",
+					value: "-",
+					ok:    true,
+				},
+				{
+					key:   "-k",
+					value: "-",
+					ok:    true,
+				},
+			},
+			expectedCount: 2,
+		},
 		{
 			title: "long form with comma separated value and equal sign",
 			in:    []string{"--key1=a,b,c"},
@@ -1354,3 +1372,203 @@ func TestHelpFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestHelpTopic(t *testing.T) {
+	testCases := []struct {
+		title                string
+		in                   []string
+		expectedHelp         bool
+		expectedTopic        string
+		expectedRevealHidden bool
+		expectedArguments    map[string]string
+	}{
+		{
+			title:        "help flag without topic",
+			in:           []string{"--help"},
+			expectedHelp: true,
+		},
+		{
+			title:         "long help flag with topic",
+			in:            []string{"--help=port"},
+			expectedHelp:  true,
+			expectedTopic: "port",
+		},
+		{
+			title:         "short help flag with topic",
+			in:            []string{"-h=P"},
+			expectedHelp:  true,
+			expectedTopic: "P",
+		},
+		{
+			title:         "topic with dashes",
+			in:            []string{"--help=--port"},
+			expectedHelp:  true,
+			expectedTopic: "--port",
+		},
+		{
+			title:        "boolean help value",
+			in:           []string{"--help=TRUE"},
+			expectedHelp: true,
+		},
+		{
+			title:         "help command with topic",
+			in:            []string{"help", "port"},
+			expectedHelp:  true,
+			expectedTopic: "port",
+		},
+		{
+			title:             "help command with topic and other arguments",
+			in:                []string{"help", "port", "--port", "80"},
+			expectedHelp:      true,
+			expectedTopic:     "port",
+			expectedArguments: map[string]string{"--port": "80"},
+		},
+		{
+			title:             "help command without topic",
+			in:                []string{"help", "--port", "80"},
+			expectedHelp:      true,
+			expectedArguments: map[string]string{"--port": "80"},
+		},
+		{
+			title:        "help command only",
+			in:           []string{"help"},
+			expectedHelp: true,
+		},
+		{
+			title:             "help as a value",
+			in:                []string{"--command", "help"},
+			expectedArguments: map[string]string{"--command": "help"},
+		},
+		{
+			title:                "help all flag",
+			in:                   []string{"--help-all"},
+			expectedHelp:         true,
+			expectedRevealHidden: true,
+		},
+		{
+			title:                "help all flag with topic",
+			in:                   []string{"--help-all=secret"},
+			expectedHelp:         true,
+			expectedTopic:        "secret",
+			expectedRevealHidden: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			src, actual := newArgSource(tc.in)
+			if actual != tc.expectedHelp {
+				t.Errorf("Help Request, Expected: %v, Actual: %v", tc.expectedHelp, actual)
+			}
+			if src.helpTopic != tc.expectedTopic {
+				t.Errorf("Help Topic, Expected: %q, Actual: %q", tc.expectedTopic, src.helpTopic)
+			}
+			if src.revealHidden != tc.expectedRevealHidden {
+				t.Errorf("Reveal Hidden, Expected: %v, Actual: %v", tc.expectedRevealHidden, src.revealHidden)
+			}
+			for key, value := range tc.expectedArguments {
+				if actual, ok := src.arguments[key]; !ok || actual != value {
+					t.Errorf("Expected %s to be %q, Actual: %q", key, value, actual)
+				}
+			}
+			if len(src.arguments) != len(tc.expectedArguments) {
+				t.Errorf("Expected %d arguments, Actual: %v", len(tc.expectedArguments), src.arguments)
+			}
+		})
+	}
+}
+
+func TestVersionFlags(t *testing.T) {
+	testCases := []struct {
+		title    string
+		in       []string
+		expected bool
+	}{
+		{
+			title:    "nil input",
+			expected: false,
+		},
+		{
+			title:    "no version flag",
+			in:       []string{"--random"},
+			expected: false,
+		},
+		{
+			title:    "with version flag",
+			in:       []string{"--version"},
+			expected: true,
+		},
+		{
+			title:    "with V flag",
+			in:       []string{"-V"},
+			expected: true,
+		},
+		{
+			title:    "with lowercase v flag",
+			in:       []string{"-v"},
+			expected: false,
+		},
+		{
+			title:    "with V flag mixed with other short forms",
+			in:       []string{"-aVb"},
+			expected: true,
+		},
+		{
+			title:    "with version flag and value",
+			in:       []string{"--version=true"},
+			expected: true,
+		},
+		{
+			title:    "version as a value",
+			in:       []string{"--flag", "version"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			src, _ := newArgSource(tc.in)
+			if src.versionRequested != tc.expected {
+				t.Errorf("Version Request, Expected: %v, Actual: %v", tc.expected, src.versionRequested)
+			}
+		})
+	}
+}
+
+func TestCompletionRequest(t *testing.T) {
+	testCases := []struct {
+		title         string
+		in            []string
+		expectedWords []string
+	}{
+		{
+			title: "nil input",
+		},
+		{
+			title: "no completion request",
+			in:    []string{"--flag", "__complete"},
+		},
+		{
+			title:         "completion request without words",
+			in:            []string{"__complete"},
+			expectedWords: []string{},
+		},
+		{
+			title:         "completion request with words",
+			in:            []string{"__complete", "--flag", "val"},
+			expectedWords: []string{"--flag", "val"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			src, _ := newArgSource(tc.in)
+			if !reflect.DeepEqual(src.completionWords, tc.expectedWords) {
+				t.Errorf("Expected Words: %q, Actual: %q", tc.expectedWords, src.completionWords)
+			}
+			if tc.expectedWords != nil && len(src.arguments) != 0 {
+				t.Errorf("Did not expect the completion words to be parsed as arguments, Actual: %v", src.arguments)
+			}
+		})
+	}
+}
@@ -2,10 +2,13 @@ package flags
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/xitonix/flags/by"
 	"github.com/xitonix/flags/config"
 	"github.com/xitonix/flags/core"
 	"github.com/xitonix/flags/internal"
@@ -34,6 +37,28 @@ type Bucket struct {
 	sources       []core.Source
 	argSource     *argSource
 	helpRequested bool
+	version       *VersionInfo
+	completions   map[string]CompletionFunc
+	fileContents  map[string]*core.FileContent
+	stdin         io.Reader
+	env           internal.EnvironmentVariableReader
+	provenance    map[string]*core.Provenance
+	logLevelFlag  bool
+}
+
+// flagGroup holds the flags which have been assigned to the same group.
+type flagGroup struct {
+	name  string
+	flags []core.Flag
+	added bool
+}
+
+// forwardedValue represents a value which must be forwarded from a deprecated flag to its replacement.
+type forwardedValue struct {
+	from    core.Flag
+	to      core.Flag
+	value   string
+	content string
 }
 
 // NewBucket creates a new bucket.
@@ -58,6 +83,9 @@ func newBucket(args []string, envReader internal.EnvironmentVariableReader, opts
 		argSource:     argSource,
 		helpRequested: helpRequested,
 		opts:          ops,
+		stdin:         os.Stdin,
+		env:           envReader,
+		provenance:    make(map[string]*core.Provenance),
 	}
 }
 
@@ -77,11 +105,35 @@ func (b *Bucket) Flags() []core.Flag {
 func (b *Bucket) Help() {
 	err := b.help()
 	if err != nil {
-		b.opts.Logger.Print(err)
+		b.print(err)
 		b.opts.Terminator.Terminate(core.FailureExitCode)
 	}
 }
 
+// Version enables the built-in version flag (--version, -V) and sets the version of the running tool.
+//
+// Providing the version flag will print the version information using the bucket's version template and
+// terminate the execution (See config.WithVersionTemplate). Both --version and -V will be reserved once
+// the built-in version flag has been enabled.
+//
+// The returned object can be used to set the other version details, such as the commit hash.
+func (b *Bucket) Version(version string) *VersionInfo {
+	b.version = newVersionInfo(version, false)
+	b.reg.reserve("--version", "-V")
+	return b.version
+}
+
+// VersionFromBuildInfo enables the built-in version flag (--version, -V) and populates the version information
+// from the build information embedded in the running binary.
+//
+// The module version, VCS revision, time and dirty state will be extracted using runtime/debug.ReadBuildInfo().
+// The returned object can be used to override the extracted values.
+func (b *Bucket) VersionFromBuildInfo() *VersionInfo {
+	b.version = newVersionInfo("", true)
+	b.reg.reserve("--version", "-V")
+	return b.version
+}
+
 // Parse parses the flags and queries all the available sources in order, to fill the value of each flag.
 //
 // If none of the sources offers any value, the flag will be set to the specified Default value (if any).
@@ -95,69 +147,108 @@ func (b *Bucket) Help() {
 //
 // See flags.EnableAutoKeyGeneration(), flags.SetKeyPrefix() and each flag types' WithKey() method for more details.
 func (b *Bucket) Parse() {
+	b.applyTheme()
+	b.applyMessages()
 	b.init()
+	if !b.applyLogLevel() {
+		return
+	}
+	b.debug("parsing flags", core.NewAttr("flags", len(b.flags)), core.NewAttr("sources", len(b.sources)))
+
+	if b.argSource.completionWords != nil {
+		if err := b.printCompletions(b.argSource.completionWords); err != nil {
+			b.terminateWithError(err)
+			return
+		}
+		b.opts.Terminator.Terminate(core.SuccessExitCode)
+		return
+	}
 
 	if b.helpRequested {
-		b.Help()
+		b.debug("help requested", core.NewAttr("topic", b.argSource.helpTopic), core.NewAttr("all", b.argSource.revealHidden))
+		if err := b.help(); err != nil {
+			b.terminateWithError(err)
+			return
+		}
 		b.opts.Terminator.Terminate(core.SuccessExitCode)
 		return
 	}
+
+	if b.version != nil && b.argSource.versionRequested {
+		if err := b.printVersion(); err != nil {
+			b.terminateWithError(err)
+			return
+		}
+		b.opts.Terminator.Terminate(core.SuccessExitCode)
+		return
+	}
+
 	if err := b.checkForUnknownFlags(); err != nil {
 		b.Help()
 		b.terminateWithError(err)
 		return
 	}
 
+	forwards := make([]forwardedValue, 0)
 	for _, f := range b.flags {
 		if f.IsRequired() && f.IsDeprecated() {
 			pn := internal.GetPrintName(f.LongName(), f.ShortName())
-			b.terminateWithError(fmt.Errorf("%s is marked as deprecated. An obsolete flag cannot be mandatory", pn))
+			b.terminateWithError(internal.NewMessageErr(internal.MsgDeprecatedRequired, pn))
 			return
 		}
-		for _, src := range b.sources {
-			var (
-				found bool
-				value string
-			)
 
-			argSrc, isArgs := src.(*argSource)
-
-			if isArgs {
-				value, found = b.processArgsSource(value, found, f, argSrc)
-			}
-
-			if !found && !isArgs && f.Key().IsSet() {
-				value, found = src.Read(f.Key().String())
-			}
+		value, origin, found := b.readValue(f)
+		if !found {
+			f.ResetToDefault()
+			b.debug("no value provided", core.NewAttr("flag", f.LongName()), core.NewAttr("origin", b.provenanceOf(f).String()))
+			continue
+		}
 
-			if !found {
-				f.ResetToDefault()
-				continue
-			}
+		content, ok := b.setValue(f, value)
+		if !ok {
+			return
+		}
+		b.provenance[f.LongName()] = origin
+		b.debug("value resolved", core.NewAttr("flag", f.LongName()), core.NewAttr("origin", origin.String()))
 
-			if p, ok := f.(core.EmptyValueProvider); ok && found && internal.IsEmpty(value) {
-				value = p.EmptyValue()
-			}
-			if !b.executeCallback(f, value, false) {
-				return
-			}
-			err := f.Set(value)
-			if err != nil {
-				b.terminateWithError(err)
-				return
+		if f.IsDeprecated() {
+			if fw, ok := b.reportDeprecation(f, value, content); ok {
+				forwards = append(forwards, fw)
 			}
+		}
+	}
 
-			if !b.executeCallback(f, value, true) {
-				return
-			}
-			break
+	for _, fw := range forwards {
+		if fw.to.IsSet() {
+			b.debug("value not forwarded", core.NewAttr("from", fw.from.LongName()), core.NewAttr("to", fw.to.LongName()), core.NewAttr("reason", "the replacement flag has been set"))
+			continue
+		}
+		if !b.setContent(fw.to, fw.value, fw.content) {
+			return
+		}
+		b.provenance[fw.to.LongName()] = &core.Provenance{
+			Origin: core.Forwarded,
+			Name:   fw.from.LongName(),
+			Value:  fw.value,
 		}
+		b.debug("value forwarded", core.NewAttr("from", fw.from.LongName()), core.NewAttr("to", fw.to.LongName()))
+	}
+
+	for _, f := range b.flags {
 		if f.IsRequired() && !f.IsSet() {
 			pn := internal.GetPrintName(f.LongName(), f.ShortName())
-			b.terminateWithError(fmt.Errorf("%s flag is required.", pn))
+			b.terminateWithError(internal.NewMessageErr(internal.MsgRequired, pn))
+			return
+		}
+	}
+
+	for _, validate := range b.opts.Validators {
+		if err := validate(b); err != nil {
+			b.terminateWithError(err)
 			return
 		}
 	}
+	b.debug("parsing completed")
 }
 
 // AppendSource appends a new source to the end of the source chain.
@@ -411,6 +502,33 @@ func (b *Bucket) DurationSlice(longName, usage string) *core.DurationSliceFlag {
 	return f
 }
 
+// ByteSize adds a new byte size flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. max-size).
+//
+// A byte size string is a non-negative decimal number with an optional fraction and an optional unit suffix,
+// such as "512", "10MB" or "1.5GiB". Valid units are "B", "KB", "MB", "GB", "TB", "PB", "EB" (powers of 1000)
+// and "KiB", "MiB", "GiB", "TiB", "PiB", "EiB" (powers of 1024). The units are case insensitive.
+func (b *Bucket) ByteSize(longName, usage string) *core.ByteSizeFlag {
+	f := core.NewByteSize(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// ByteSizeSlice adds a new byte size slice flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. sizes).
+//
+// The value of a byte size slice flag can be set using a comma (or any custom delimiter) separated string of sizes.
+// For example --sizes "512KiB, 10MB, 1.5GiB".
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func (b *Bucket) ByteSizeSlice(longName, usage string) *core.ByteSizeSliceFlag {
+	f := core.NewByteSizeSlice(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
 // Time adds a new Time flag to the bucket.
 //
 // The long name will be automatically converted to lowercase by the library (i.e. birthday).
@@ -539,6 +657,87 @@ func (b *Bucket) IPAddressSlice(longName, usage string) *core.IPAddressSliceFlag
 	return f
 }
 
+// URL adds a new URL flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. server-url).
+//
+// The value of a URL flag can be specified using any URL string which can be parsed by url.Parse (i.e. "https://example.com:8443/api").
+// The acceptable schemes, the host requirement and the default ports can be defined using
+// WithSchemes(), RequireHost() and WithDefaultPort() methods.
+func (b *Bucket) URL(longName, usage string) *core.URLFlag {
+	f := core.NewURL(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// URLSlice adds a new URL slice flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. servers).
+//
+// The value of a URL slice flag can be specified using a comma (or any custom delimiter) separated string of URLs
+// (i.e. "https://a.example.com, grpc://b.example.com:50051").
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func (b *Bucket) URLSlice(longName, usage string) *core.URLSliceFlag {
+	f := core.NewURLSlice(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// FilePath adds a new file path flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. config).
+//
+// The value will be resolved to an absolute path. The leading ~ and the environment variables can be expanded
+// using ExpandHome() and ExpandEnv() methods. The path can be checked against the file system using
+// MustExist(), MustNotExist(), Readable() and Writable() methods.
+func (b *Bucket) FilePath(longName, usage string) *core.FilePathFlag {
+	f := core.NewFilePath(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// DirPath adds a new directory path flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. output-dir).
+//
+// The value will be resolved to an absolute path. The leading ~ and the environment variables can be expanded
+// using ExpandHome() and ExpandEnv() methods. The path can be checked against the file system using
+// MustExist(), MustNotExist(), Readable() and Writable() methods.
+func (b *Bucket) DirPath(longName, usage string) *core.DirPathFlag {
+	f := core.NewDirPath(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// Endpoint adds a new network endpoint flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. listen).
+//
+// The value of an endpoint flag can be specified in "host:port" or "[ipv6]:port" format (i.e. "localhost:8080" or "[::1]:8080").
+// The default port, the acceptable port range and the host requirement can be defined using
+// WithDefaultPort(), WithPortRange() and RequireHost() methods. The unix domain socket endpoints
+// (i.e. "unix:///var/run/app.sock") can be allowed using AllowUnix() method.
+func (b *Bucket) Endpoint(longName, usage string) *core.EndpointFlag {
+	f := core.NewEndpoint(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
+// EndpointSlice adds a new network endpoint slice flag to the bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. brokers).
+//
+// The value of an endpoint slice flag can be specified using a comma (or any custom delimiter) separated string of
+// endpoints in "host:port" or "[ipv6]:port" format (i.e. "10.0.0.1:9092, [::1]:9092").
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func (b *Bucket) EndpointSlice(longName, usage string) *core.EndpointSliceFlag {
+	f := core.NewEndpointSlice(longName, usage)
+	b.flags = append(b.flags, f)
+	return f
+}
+
 // CIDR adds a new CIDR (Classless Inter-Domain Routing) flag to the bucket.
 //
 // The long name will be automatically converted to lowercase by the library (i.e. network).
@@ -590,17 +789,368 @@ func (b *Bucket) Add(f core.Flag) {
 	b.flags = append(b.flags, f)
 }
 
+// FromFile enables reading the value of the flag with the specified long name from a file or stdin.
+//
+// Once enabled, the content of the file will be passed to the flag if the value starts with core.FilePrefix
+// (i.e. --cert @/path/to/cert.pem), and the content of stdin will be passed if the value is core.StdinValue
+// (i.e. --payload -). It works with any flag type, since the content will be fed into the Set method of the flag.
+//
+// The provenance of the value will record the file path instead of the content. Enabling it for a long name which has
+// not been registered will be reported as an error once the bucket gets initialised.
+//
+// Example:
+//
+// 	bucket.String("cert", "TLS certificate")
+// 	bucket.FromFile("cert", core.WithMaxFileSize(64*core.KiB), core.TrimFileContent())
+func (b *Bucket) FromFile(longName string, options ...core.FileContentOption) {
+	if b.fileContents == nil {
+		b.fileContents = make(map[string]*core.FileContent)
+	}
+	b.fileContents[internal.SanitiseLongName(longName)] = core.NewFileContent(options...)
+}
+
 func (b *Bucket) help() error {
-	flags := b.sortFlags()
-	for _, flag := range flags {
-		_, err := b.opts.HelpWriter.Write([]byte(b.opts.HelpFormatter.Format(flag, b.opts.DeprecationMark, b.opts.DefaultValueFormatString, b.opts.RequiredFlagMark)))
-		if err != nil {
+	b.applyTheme()
+	b.applyTerminalWidth()
+	b.applyMessages()
+	if kf, ok := b.opts.HelpFormatter.(core.KeyFormatSetter); ok {
+		kf.SetKeyFormat(&core.KeyFormat{
+			FormatString: b.opts.KeyFormatString,
+			PresenceMark: b.opts.EnvPresenceMark,
+			Lookup:       b.env.Get,
+		})
+	}
+	if pa, ok := b.opts.HelpFormatter.(core.ProvenanceAware); ok {
+		pa.SetProvenanceLookup(b.provenanceOf)
+	}
+	if hr, ok := b.opts.HelpFormatter.(core.HiddenFlagRevealer); ok {
+		hr.RevealHidden(b.argSource.revealHidden)
+	}
+	if !internal.IsEmpty(b.argSource.helpTopic) {
+		return b.flagHelp(b.argSource.helpTopic)
+	}
+	groups := b.groupFlags()
+	gf, hasHeadings := b.opts.HelpFormatter.(core.GroupFormatter)
+	hasHeadings = hasHeadings && groups != nil
+
+	sf, hasSections := b.opts.HelpFormatter.(core.HelpSectionFormatter)
+	sections := b.helpSections()
+	hasSections = hasSections && sections != nil
+	if hasSections {
+		sections.Grouped = hasHeadings
+		if err := b.writeHelp(sf.FormatHeader(sections)); err != nil {
+			return err
+		}
+	}
+
+	if groups == nil {
+		groups = []*flagGroup{{flags: b.sortFlags()}}
+	}
+
+	for i, group := range groups {
+		if hasHeadings {
+			if err := b.writeHelp(gf.FormatGroup(group.name, i)); err != nil {
+				return err
+			}
+		}
+		for _, flag := range group.flags {
+			_, err := b.opts.HelpWriter.Write([]byte(b.opts.HelpFormatter.Format(flag, b.opts.DeprecationMark, b.opts.DefaultValueFormatString, b.opts.RequiredFlagMark)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if hasSections {
+		if err := b.writeHelp(sf.FormatFooter(sections)); err != nil {
 			return err
 		}
 	}
 	return b.opts.HelpWriter.Close()
 }
 
+// groupFlags splits the visible flags into sorted groups in the configured group order.
+//
+// It returns nil if none of the visible flags has been assigned to a group.
+func (b *Bucket) groupFlags() []*flagGroup {
+	defaultTitle := strings.TrimSpace(b.opts.DefaultGroupTitle)
+	groups := make(map[string]*flagGroup)
+	declared := make([]string, 0)
+	var grouped bool
+	for _, f := range b.flags {
+		if f.IsHidden() && !b.argSource.revealHidden {
+			continue
+		}
+		name := core.GroupOf(f)
+		if internal.IsEmpty(name) {
+			name = defaultTitle
+		} else {
+			grouped = true
+		}
+		group, ok := groups[name]
+		if !ok {
+			group = &flagGroup{name: name}
+			groups[name] = group
+			declared = append(declared, name)
+		}
+		group.flags = append(group.flags, f)
+	}
+
+	if !grouped {
+		return nil
+	}
+
+	order := make([]string, 0)
+	var defaultListed bool
+	for _, name := range b.opts.GroupOrder {
+		name = strings.TrimSpace(name)
+		defaultListed = defaultListed || name == defaultTitle
+		order = append(order, name)
+	}
+	// The ungrouped flags will be printed first, unless the default section has been explicitly listed.
+	if !defaultListed {
+		order = append([]string{defaultTitle}, order...)
+	}
+	order = append(order, declared...)
+
+	result := make([]*flagGroup, 0)
+	for _, name := range order {
+		group, ok := groups[name]
+		if !ok || group.added {
+			continue
+		}
+		group.added = true
+		comparer := b.opts.Comparer
+		if c, ok := b.opts.GroupComparers[name]; ok {
+			comparer = c
+		}
+		group.flags = sortFlags(group.flags, comparer)
+		result = append(result, group)
+	}
+	return result
+}
+
+// applyTheme sets the bucket's theme on the help formatter and the logger (if they support styling).
+//
+// Styling will be disabled if the NO_COLOR environment variable is set. The help output will only be styled
+// if the help writer is a terminal.
+func (b *Bucket) applyTheme() {
+	theme := b.opts.Theme
+	if noColor, ok := b.env.Get("NO_COLOR"); ok && !internal.IsEmpty(noColor) {
+		theme = nil
+	}
+
+	if l, ok := b.opts.Logger.(core.Themeable); ok {
+		l.SetTheme(theme)
+	}
+
+	if hf, ok := b.opts.HelpFormatter.(core.Themeable); ok {
+		if hw, ok := b.opts.HelpWriter.(core.TerminalWriter); ok && hw.IsTerminal() {
+			hf.SetTheme(theme)
+		} else {
+			hf.SetTheme(nil)
+		}
+	}
+}
+
+// applyTerminalWidth passes the value of the COLUMNS environment variable to the help writer (if it supports line wrapping).
+func (b *Bucket) applyTerminalWidth() {
+	if ws, ok := b.opts.HelpWriter.(core.TerminalWidthSetter); ok {
+		if width := internal.ColumnsWidth(b.env); width > 0 {
+			ws.SetTerminalWidth(width)
+		}
+	}
+}
+
+// applyMessages passes the bucket's message catalogue to the help formatter.
+//
+// The English default deprecation mark, default value format string and default group title will be replaced
+// by their translations.
+func (b *Bucket) applyMessages() {
+	messages := b.messages()
+	if b.opts.DeprecationMark == config.DeprecatedFlagIndicatorDefault {
+		b.opts.DeprecationMark = messages.Message(core.MsgDeprecationMark)
+	}
+	if b.opts.DefaultValueFormatString == config.DefaultValueFormatStringDefault {
+		b.opts.DefaultValueFormatString = messages.Message(core.MsgDefaultValue)
+	}
+	if b.opts.DefaultGroupTitle == config.DefaultGroupTitleDefault {
+		b.opts.DefaultGroupTitle = messages.Message(core.MsgDefaultGroupTitle)
+		b.renameDefaultGroup(b.opts.DefaultGroupTitle)
+	}
+	if ms, ok := b.opts.HelpFormatter.(core.MessageCatalogueSetter); ok {
+		ms.SetMessageCatalogue(messages)
+	}
+}
+
+// renameDefaultGroup replaces the default group title within the group orders with its translation, so that the
+// ungrouped flags section can still be referred to by its English title (i.e. config.WithGroupOrder("General")).
+func (b *Bucket) renameDefaultGroup(title string) {
+	if title == config.DefaultGroupTitleDefault {
+		return
+	}
+	order := make([]string, len(b.opts.GroupOrder))
+	for i, name := range b.opts.GroupOrder {
+		if strings.TrimSpace(name) == config.DefaultGroupTitleDefault {
+			name = title
+		}
+		order[i] = name
+	}
+	b.opts.GroupOrder = order
+	if c, ok := b.opts.GroupComparers[config.DefaultGroupTitleDefault]; ok {
+		delete(b.opts.GroupComparers, config.DefaultGroupTitleDefault)
+		if _, ok := b.opts.GroupComparers[title]; !ok {
+			b.opts.GroupComparers[title] = c
+		}
+	}
+}
+
+// messages returns the bucket's message catalogue, or the English catalogue if it has not been set.
+func (b *Bucket) messages() core.MessageCatalogue {
+	if b.opts.Messages == nil {
+		return core.EnglishCatalogue{}
+	}
+	return b.opts.Messages
+}
+
+// logLevelFlag is the name of the built-in flag which sets the minimum level of the logger.
+const logLevelFlag = "--log-level"
+
+// EnableLogLevelFlag enables the built-in --log-level flag.
+//
+// The flag sets the minimum level of the bucket's logger (debug, info, warn or error), if the logger implements
+// core.LevelSetter. The parsing process can be traced by providing --log-level=debug, if the logger also implements
+// core.LeveledLogger (i.e. config.Logger). The --log-level flag will be reserved once it has been enabled.
+func (b *Bucket) EnableLogLevelFlag() {
+	b.logLevelFlag = true
+	b.reg.reserve(logLevelFlag)
+}
+
+// applyLogLevel sets the minimum level of the logger, if the --log-level flag has been provided.
+//
+// The method returns false if the execution has been terminated.
+func (b *Bucket) applyLogLevel() bool {
+	if !b.logLevelFlag {
+		return true
+	}
+	value, ok := b.argSource.Read(logLevelFlag)
+	if !ok {
+		return true
+	}
+	level, err := core.ParseLevel(value)
+	if err != nil {
+		b.terminateWithError(internal.InvalidValueErr(value, strings.TrimPrefix(logLevelFlag, "--"), "", "log level"))
+		return false
+	}
+	if ls, ok := b.opts.Logger.(core.LevelSetter); ok {
+		ls.SetLevel(level)
+	}
+	return true
+}
+
+// debug reports a debug event to the logger, if the logger supports levels and the debug level is enabled.
+func (b *Bucket) debug(msg string, attrs ...core.Attr) {
+	if l, ok := b.opts.Logger.(core.LeveledLogger); ok && l.Enabled(core.LevelDebug) {
+		l.Log(core.LevelDebug, msg, attrs...)
+	}
+}
+
+// warn translates the deprecation warning and reports it to the logger.
+//
+// The warning will be logged at warning level if the logger supports levels.
+func (b *Bucket) warn(w *core.DeprecationWarning) {
+	w.Localise(b.messages().Message)
+	if l, ok := b.opts.Logger.(core.LeveledLogger); ok {
+		l.Log(core.LevelWarn, w.Error())
+		return
+	}
+	b.opts.Logger.Print(w)
+}
+
+// print translates the localisable errors and reports them to the logger.
+func (b *Bucket) print(err error) {
+	if le, ok := err.(core.LocalisableError); ok {
+		le.Localise(b.messages().Message)
+	}
+	b.opts.Logger.Print(err)
+}
+
+// helpSections returns the program level sections of the help output.
+//
+// It returns nil if none of the synopsis, description, examples or footer have been configured.
+func (b *Bucket) helpSections() *core.HelpSections {
+	if internal.IsEmpty(b.opts.Synopsis) &&
+		internal.IsEmpty(b.opts.Description) &&
+		len(b.opts.Examples) == 0 &&
+		internal.IsEmpty(b.opts.Footer) {
+		return nil
+	}
+	return &core.HelpSections{
+		ProgramName: b.opts.ProgramName,
+		Usage:       b.synopsis(),
+		Description: b.opts.Description,
+		Examples:    b.opts.Examples,
+		Footer:      b.opts.Footer,
+	}
+}
+
+// flagHelp writes the help page of the flag with the specified name (i.e. port, --port or -p).
+//
+// The page includes the value to which the flag resolves, given the current sources. The hidden flags will be
+// treated as unknown flags, unless the page has been requested using --help-all.
+func (b *Bucket) flagHelp(name string) error {
+	f := b.lookupFlag(name)
+	if f == nil || (f.IsHidden() && !b.argSource.revealHidden) {
+		if !strings.HasPrefix(name, "-") {
+			name = internal.GetPrintName(name, "")
+		}
+		return core.NewUnknownFlagErr(name)
+	}
+
+	details := &core.FlagDetails{
+		Flag:       f,
+		Provenance: &core.Provenance{Origin: core.Unset},
+	}
+	if value, provenance, found := b.readValue(f); found {
+		details.Value = core.MaskValueOf(f, value)
+		details.Provenance = core.MaskProvenanceOf(f, provenance)
+	} else if dv := f.Default(); dv != nil {
+		details.Value = core.FormatValue(dv)
+		details.Provenance = &core.Provenance{Origin: core.DefaultValue}
+	}
+
+	page := b.opts.HelpFormatter.Format(f, b.opts.DeprecationMark, b.opts.DefaultValueFormatString, b.opts.RequiredFlagMark)
+	if df, ok := b.opts.HelpFormatter.(core.FlagDetailsFormatter); ok {
+		page = df.FormatDetails(details, b.opts.DeprecationMark, b.opts.DefaultValueFormatString, b.opts.RequiredFlagMark)
+	}
+	if err := b.writeHelp(page); err != nil {
+		return err
+	}
+	return b.opts.HelpWriter.Close()
+}
+
+// lookupFlag returns the flag with the specified long or short name (i.e. port, --port or -p).
+func (b *Bucket) lookupFlag(name string) core.Flag {
+	name = strings.TrimLeft(strings.TrimSpace(name), "-")
+	if len(name) == 1 {
+		for _, f := range b.flags {
+			if f.ShortName() == name {
+				return f
+			}
+		}
+	}
+	return b.findFlag(internal.SanitiseLongName(name))
+}
+
+// writeHelp writes the non-empty section into the help writer.
+func (b *Bucket) writeHelp(section string) error {
+	if len(section) == 0 {
+		return nil
+	}
+	_, err := b.opts.HelpWriter.Write([]byte(section))
+	return err
+}
+
 func (b *Bucket) checkForUnknownFlags() error {
 	for arg := range b.argSource.arguments {
 		if b.reg.isRegistered(arg) || b.reg.isReserved(arg) {
@@ -612,6 +1162,44 @@ func (b *Bucket) checkForUnknownFlags() error {
 }
 
 func (b *Bucket) init() {
+	b.initKeys()
+	for _, f := range b.flags {
+		err := b.reg.add(f)
+		if err != nil {
+			b.print(err)
+			b.opts.Terminator.Terminate(core.FailureExitCode)
+		}
+	}
+
+	for _, f := range b.flags {
+		if err := b.checkReplacement(f); err != nil {
+			b.print(err)
+			b.opts.Terminator.Terminate(core.FailureExitCode)
+		}
+	}
+
+	if err := b.checkFileContents(); err != nil {
+		b.print(err)
+		b.opts.Terminator.Terminate(core.FailureExitCode)
+	}
+
+	for _, f := range b.flags {
+		if err := b.checkRange(f); err != nil {
+			b.print(err)
+			b.opts.Terminator.Terminate(core.FailureExitCode)
+		}
+	}
+
+	for _, f := range b.flags {
+		if err := b.checkDefault(f); err != nil {
+			b.print(err)
+			b.opts.Terminator.Terminate(core.FailureExitCode)
+		}
+	}
+}
+
+// initKeys applies the bucket's key settings (prefix and automatic key generation) to all the registered flags.
+func (b *Bucket) initKeys() {
 	for _, f := range b.flags {
 		if !internal.IsEmpty(b.opts.KeyPrefix) {
 			f.Key().SetPrefix(b.opts.KeyPrefix)
@@ -620,23 +1208,92 @@ func (b *Bucket) init() {
 		if b.opts.AutoKeys && !f.Key().IsSet() {
 			f.Key().SetID(f.LongName())
 		}
-		err := b.reg.add(f)
-		if err != nil {
-			b.opts.Logger.Print(err)
-			b.opts.Terminator.Terminate(core.FailureExitCode)
+	}
+}
+
+func (b *Bucket) checkReplacement(f core.Flag) error {
+	p, ok := f.(core.DeprecationProvider)
+	if !ok || p.Deprecation() == nil || internal.IsEmpty(p.Deprecation().Replacement) {
+		return nil
+	}
+	var short string
+	if !internal.IsEmpty(f.ShortName()) {
+		short = "-" + f.ShortName()
+	}
+	replacement := p.Deprecation().Replacement
+	if replacement == f.LongName() {
+		return core.NewInvalidFlagMessageErr("--"+f.LongName(), short, "", core.MsgSelfReplacement)
+	}
+	if !b.reg.isRegistered("--" + replacement) {
+		return core.NewInvalidFlagMessageErr("--"+f.LongName(), short, "", core.MsgMissingReplacement, replacement)
+	}
+	return nil
+}
+
+// checkFileContents makes sure that the values can only be read from a file for the registered flags (See FromFile).
+func (b *Bucket) checkFileContents() error {
+	names := make([]string, 0, len(b.fileContents))
+	for name := range b.fileContents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !b.reg.isRegistered("--" + name) {
+			return core.NewInvalidFlagMessageErr("--"+name, "", "", core.MsgUnknownFileFlag)
 		}
 	}
+	return nil
+}
+
+// checkRange makes sure that the bounds of the acceptable values of the flag do not contradict each other.
+//
+// The bounds of the constraints (i.e. the length of the strings or the number of the items) will also be checked.
+func (b *Bucket) checkRange(f core.Flag) error {
+	var short string
+	if !internal.IsEmpty(f.ShortName()) {
+		short = "-" + f.ShortName()
+	}
+	if r := core.RangeOf(f); r.IsEmpty() {
+		return core.NewInvalidFlagMessageErr("--"+f.LongName(), short, "", core.MsgInvalidRange, r)
+	}
+	for _, c := range core.ConstraintsOf(f) {
+		for _, arg := range c.Args {
+			if r, ok := arg.(*core.Range); ok && r.IsEmpty() {
+				return core.NewInvalidFlagMessageErr("--"+f.LongName(), short, "", core.MsgInvalidConstraint, c.Format(b.messages()))
+			}
+		}
+	}
+	return nil
+}
+
+// checkDefault validates the default value of the flag against its validation rules.
+func (b *Bucket) checkDefault(f core.Flag) error {
+	err := core.ValidateDefaultOf(f)
+	if err == nil {
+		return nil
+	}
+	var short string
+	if !internal.IsEmpty(f.ShortName()) {
+		short = "-" + f.ShortName()
+	}
+	return core.NewInvalidFlagMessageErr("--"+f.LongName(), short, "", core.MsgInvalidDefault, err)
 }
 
 func (b *Bucket) sortFlags() []core.Flag {
-	if b.opts.Comparer == nil {
-		return b.flags
+	return sortFlags(b.flags, b.opts.Comparer)
+}
+
+func sortFlags(flags []core.Flag, comparer by.Comparer) []core.Flag {
+	if comparer == nil {
+		return flags
 	}
 
-	clone := make([]core.Flag, len(b.flags))
-	copy(clone, b.flags)
-	sort.Slice(clone, func(i, j int) bool {
-		return b.opts.Comparer.LessThan(clone[i], clone[j])
+	clone := make([]core.Flag, len(flags))
+	copy(clone, flags)
+	// The declaration order is the final tiebreaker. The flags which are less than each other (i.e. two required flags
+	// compared by by.RequiredFirst) are considered equal, so that the stable sort keeps them in order.
+	sort.SliceStable(clone, func(i, j int) bool {
+		return comparer.LessThan(clone[i], clone[j]) && !comparer.LessThan(clone[j], clone[i])
 	})
 	return clone
 }
@@ -658,14 +1315,169 @@ func (b *Bucket) executeCallback(f core.Flag, value string, post bool) bool {
 }
 
 func (b *Bucket) terminateWithError(err error) {
-	b.opts.Logger.Print(err)
+	b.print(err)
 	b.opts.Terminator.Terminate(core.FailureExitCode)
 }
 
-func (b *Bucket) processArgsSource(value string, found bool, f core.Flag, argSrc *argSource) (string, bool) {
-	value, found = argSrc.Read("--" + f.LongName())
+// readValue queries all the available sources in order and returns the first value found for the flag.
+func (b *Bucket) readValue(f core.Flag) (string, *core.Provenance, bool) {
+	for _, src := range b.sources {
+		var (
+			found bool
+			value string
+			name  string
+		)
+
+		argSrc, isArgs := src.(*argSource)
+
+		if isArgs {
+			value, name, found = b.processArgsSource(f, argSrc)
+			b.debug("source queried", core.NewAttr("flag", f.LongName()), core.NewAttr("source", sourceName(src)), core.NewAttr("found", found))
+		}
+
+		if !found && !isArgs {
+			if !f.Key().IsSet() {
+				b.debug("source skipped", core.NewAttr("flag", f.LongName()), core.NewAttr("source", sourceName(src)), core.NewAttr("reason", "the flag has no key"))
+				continue
+			}
+			name = f.Key().String()
+			value, found = src.Read(name)
+			b.debug("source queried", core.NewAttr("flag", f.LongName()), core.NewAttr("source", sourceName(src)), core.NewAttr("key", name), core.NewAttr("found", found))
+		}
+
+		if !found {
+			continue
+		}
+
+		if p, ok := f.(core.EmptyValueProvider); ok && internal.IsEmpty(value) {
+			value = p.EmptyValue()
+		}
+		return value, newProvenance(src, name, value), true
+	}
+	return "", nil, false
+}
+
+// sourceName returns the name of the source which will be used in the debug events.
+func sourceName(src core.Source) string {
+	switch src.(type) {
+	case *argSource:
+		return core.CommandLine.String()
+	case *envVariableSource:
+		return core.Environment.String()
+	default:
+		return fmt.Sprintf("%T", src)
+	}
+}
+
+func newProvenance(src core.Source, name, value string) *core.Provenance {
+	origin := core.CustomSource
+	switch src.(type) {
+	case *argSource:
+		origin = core.CommandLine
+	case *envVariableSource:
+		origin = core.Environment
+	}
+	return &core.Provenance{
+		Origin: origin,
+		Name:   name,
+		Source: src,
+		Value:  value,
+	}
+}
+
+// Provenance returns the details of where the value of the specified flag came from.
+//
+// The method returns nil if no flag with the specified long name has been registered. The origin of the flags
+// which have not been provided by any of the sources will either be core.DefaultValue or core.Unset.
+func (b *Bucket) Provenance(longName string) *core.Provenance {
+	f := b.findFlag(internal.SanitiseLongName(longName))
+	if f == nil {
+		return nil
+	}
+	return b.provenanceOf(f)
+}
+
+// Lookup returns the flag with the specified long name.
+//
+// The method returns nil if no flag with the specified long name has been registered.
+func (b *Bucket) Lookup(longName string) core.Flag {
+	return b.findFlag(internal.SanitiseLongName(longName))
+}
+
+func (b *Bucket) provenanceOf(f core.Flag) *core.Provenance {
+	if p, ok := b.provenance[f.LongName()]; ok {
+		return p
+	}
+	if f.Default() != nil {
+		return &core.Provenance{Origin: core.DefaultValue}
+	}
+	return &core.Provenance{Origin: core.Unset}
+}
+
+// setValue resolves the content of the value (See FromFile) and passes it to the flag.
+//
+// The method returns the resolved content, or false if the execution has been terminated.
+func (b *Bucket) setValue(f core.Flag, value string) (string, bool) {
+	content := value
+	if fc, ok := b.fileContents[f.LongName()]; ok {
+		var err error
+		if content, err = fc.Resolve(value, b.stdin, f.LongName(), f.ShortName()); err != nil {
+			b.terminateWithError(err)
+			return "", false
+		}
+	}
+	return content, b.setContent(f, value, content)
+}
+
+// setContent sets the flag value to the resolved content and executes the pre/post Set callbacks.
+//
+// The method returns false if the execution has been terminated.
+func (b *Bucket) setContent(f core.Flag, value, content string) bool {
+	if !b.executeCallback(f, value, false) {
+		return false
+	}
+	if err := f.Set(content); err != nil {
+		b.terminateWithError(err)
+		return false
+	}
+	return b.executeCallback(f, value, true)
+}
+
+// reportDeprecation reports the usage of a deprecated flag to the logger.
+//
+// The method returns true if the value must be forwarded to the replacement flag. The resolved content of the value
+// will be forwarded, so that the files and stdin will not be read again.
+func (b *Bucket) reportDeprecation(f core.Flag, value, content string) (forwardedValue, bool) {
+	var deprecation *core.Deprecation
+	if p, ok := f.(core.DeprecationProvider); ok {
+		deprecation = p.Deprecation()
+	}
+	b.warn(core.NewDeprecationWarning(f.LongName(), f.ShortName(), deprecation))
+	if deprecation == nil || !deprecation.ForwardValue || internal.IsEmpty(deprecation.Replacement) {
+		return forwardedValue{}, false
+	}
+	to := b.findFlag(deprecation.Replacement)
+	if to == nil {
+		return forwardedValue{}, false
+	}
+	return forwardedValue{from: f, to: to, value: value, content: content}, true
+}
+
+func (b *Bucket) findFlag(longName string) core.Flag {
+	for _, f := range b.flags {
+		if f.LongName() == longName {
+			return f
+		}
+	}
+	return nil
+}
+
+func (b *Bucket) processArgsSource(f core.Flag, argSrc *argSource) (string, string, bool) {
+	name := "--" + f.LongName()
+	value, found := argSrc.Read(name)
 	if !found {
-		value, found = argSrc.Read("-" + f.ShortName())
+		name = "-" + f.ShortName()
+		value, found = argSrc.Read(name)
 	}
 	if !found || internal.IsEmpty(value) {
 		if repeatable, isRepeatable := f.(core.Repeatable); isRepeatable {
@@ -678,5 +1490,5 @@ func (b *Bucket) processArgsSource(value string, found bool, f core.Flag, argSrc
 			}
 		}
 	}
-	return value, found
+	return value, name, found
 }
@@ -1,10 +1,16 @@
 package flags
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/xitonix/flags/by"
 	"github.com/xitonix/flags/config"
@@ -71,6 +77,137 @@ func TestBucket_Parse_Required(t *testing.T) {
 	}
 }
 
+func TestBucket_Parse_Deprecation_Warning(t *testing.T) {
+	testCases := []struct {
+		title                    string
+		args                     []string
+		envVars                  map[string]string
+		deprecated, replacement  *mocks.Flag
+		expectedWarning          string
+		expectedReplacementValue interface{}
+		mustTerminate            bool
+	}{
+		{
+			title:      "deprecated flag not provided",
+			deprecated: mocks.NewFlag("old", "o").MarkAsDeprecated(),
+		},
+		{
+			title:           "deprecated flag provided by the command line",
+			args:            []string{"--old", "value"},
+			deprecated:      mocks.NewFlag("old", "o").MarkAsDeprecated(),
+			expectedWarning: "-o, --old is deprecated.",
+		},
+		{
+			title:           "deprecated flag provided by environment variables",
+			envVars:         map[string]string{"OLD": "value"},
+			deprecated:      mocks.NewFlag("old", "o").WithKey("old").MarkAsDeprecated(),
+			expectedWarning: "-o, --old is deprecated.",
+		},
+		{
+			title:           "deprecated flag with message",
+			args:            []string{"--old", "value"},
+			deprecated:      mocks.NewFlag("old", "").MarkAsDeprecated(core.WithDeprecationMessage("It will be removed in v2.")),
+			expectedWarning: "--old is deprecated. It will be removed in v2.",
+		},
+		{
+			title:                    "deprecated flag with replacement",
+			args:                     []string{"--old", "value"},
+			deprecated:               mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("new")),
+			replacement:              mocks.NewFlag("new", "n"),
+			expectedWarning:          "--old is deprecated. Use --new instead.",
+			expectedReplacementValue: "",
+		},
+		{
+			title:                    "deprecated flag with message and replacement",
+			args:                     []string{"--old", "value"},
+			deprecated:               mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("new"), core.WithDeprecationMessage("Legacy.")),
+			replacement:              mocks.NewFlag("new", "n"),
+			expectedWarning:          "--old is deprecated. Legacy. Use --new instead.",
+			expectedReplacementValue: "",
+		},
+		{
+			title:                    "forward the value to the replacement flag",
+			args:                     []string{"--old", "value"},
+			deprecated:               mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("new"), core.ForwardValue()),
+			replacement:              mocks.NewFlag("new", "n"),
+			expectedWarning:          "--old is deprecated. Use --new instead.",
+			expectedReplacementValue: "value",
+		},
+		{
+			title:                    "do not override the explicit value of the replacement flag",
+			args:                     []string{"--old", "value", "--new", "explicit"},
+			deprecated:               mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("new"), core.ForwardValue()),
+			replacement:              mocks.NewFlag("new", "n"),
+			expectedWarning:          "--old is deprecated. Use --new instead.",
+			expectedReplacementValue: "explicit",
+		},
+		{
+			title:           "unregistered replacement flag",
+			deprecated:      mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("new")),
+			expectedWarning: "--old replacement flag --new does not exist",
+			mustTerminate:   true,
+		},
+		{
+			title:           "self replacement",
+			deprecated:      mocks.NewFlag("old", "").MarkAsDeprecated(core.WithReplacement("old")),
+			expectedWarning: "--old cannot be replaced by itself",
+			mustTerminate:   true,
+		},
+		{
+			title:           "self replacement with short name",
+			deprecated:      mocks.NewFlag("old", "o").MarkAsDeprecated(core.WithReplacement("old")),
+			expectedWarning: "--old, -o cannot be replaced by itself",
+			mustTerminate:   true,
+		},
+		{
+			title:           "unregistered replacement flag with short name",
+			deprecated:      mocks.NewFlag("old", "o").MarkAsDeprecated(core.WithReplacement("new")),
+			expectedWarning: "--old, -o replacement flag --new does not exist",
+			mustTerminate:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			bucket := newBucket(tc.args, env,
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm))
+
+			bucket.flags = append(bucket.flags, tc.deprecated)
+			if tc.replacement != nil {
+				bucket.flags = append(bucket.flags, tc.replacement)
+			}
+
+			bucket.Parse()
+
+			if tm.IsTerminated != tc.mustTerminate {
+				t.Errorf("Expected IsTerminated: %v, Actual: %v", tc.mustTerminate, tm.IsTerminated)
+			}
+
+			if !test.ErrorContainsExact(lg.Error, tc.expectedWarning) {
+				t.Errorf("Expected '%v', but received %v", tc.expectedWarning, lg.Error)
+			}
+
+			if !tc.mustTerminate && tc.expectedWarning != "" {
+				if _, ok := lg.Error.(*core.DeprecationWarning); !ok {
+					t.Errorf("Expected a deprecation warning, but received %T", lg.Error)
+				}
+			}
+
+			if tc.replacement != nil && tc.replacement.Get() != tc.expectedReplacementValue {
+				t.Errorf("Expected Replacement Value: %v, Actual: %v", tc.expectedReplacementValue, tc.replacement.Get())
+			}
+		})
+	}
+}
+
 func TestBucket_Parse_Validation(t *testing.T) {
 	testCases := []struct {
 		title                   string
@@ -185,6 +322,386 @@ func TestBucket_Parse_Validation(t *testing.T) {
 	}
 }
 
+func TestBucket_Parse_Invalid_Default(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          core.Flag
+		expectedErr   string
+		mustTerminate bool
+	}{
+		{
+			title: "acceptable default value",
+			flag:  core.NewInt("port", "usage").WithBetween(1, 65535).WithDefault(8080),
+		},
+		{
+			title:         "default value outside of the bounds",
+			flag:          core.NewInt("port", "usage").WithShort("p").WithBetween(1, 65535).WithDefault(0),
+			expectedErr:   "--port, -p has an invalid default value. 0 is not an acceptable value for -p, --port. The expected range is [1, 65535].",
+			mustTerminate: true,
+		},
+		{
+			title:         "default value outside of the valid range",
+			flag:          core.NewString("format", "usage").WithValidRange(true, "json", "yaml").WithDefault("xml"),
+			expectedErr:   "--format has an invalid default value. xml is not an acceptable value for --format. The expected values are json,yaml.",
+			mustTerminate: true,
+		},
+		{
+			title:         "lower bound greater than the upper bound",
+			flag:          core.NewInt("port", "usage").WithShort("p").WithBetween(10, 1),
+			expectedErr:   "--port, -p has an invalid range of acceptable values [10, 1]. No value can fall within the range.",
+			mustTerminate: true,
+		},
+		{
+			title:         "minimum length greater than the maximum length",
+			flag:          core.NewString("name", "usage").WithMinLength(10).WithMaxLength(5),
+			expectedErr:   "--name has an invalid constraint (length: [10, 5]). No value can satisfy the constraint.",
+			mustTerminate: true,
+		},
+		{
+			title:         "minimum number of items greater than the maximum number of items",
+			flag:          core.NewStringSlice("names", "usage").WithMinItems(3).WithMaxItems(1),
+			expectedErr:   "--names has an invalid constraint (items: [3, 1]). No value can satisfy the constraint.",
+			mustTerminate: true,
+		},
+		{
+			title: "equal length bounds",
+			flag:  core.NewString("name", "usage").WithMinLength(5).WithMaxLength(5),
+		},
+		{
+			title:         "default value of a required flag",
+			flag:          core.NewDuration("timeout", "usage").WithMin(time.Second).WithDefault(0).Required(),
+			expectedErr:   "--timeout has an invalid default value. 0s is not an acceptable value for --timeout. The expected range is >= 1s.",
+			mustTerminate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			bucket := newBucket([]string{}, mocks.NewEnvReader(),
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm))
+			bucket.flags = append(bucket.flags, tc.flag)
+
+			bucket.init()
+
+			if tm.IsTerminated != tc.mustTerminate {
+				t.Errorf("Expected IsTerminated: %v, Actual: %v", tc.mustTerminate, tm.IsTerminated)
+			}
+
+			if !test.ErrorContainsExact(lg.Error, tc.expectedErr) {
+				t.Errorf("Expected '%v', but received %v", tc.expectedErr, lg.Error)
+			}
+
+			if tc.mustTerminate {
+				if _, ok := lg.Error.(*core.ErrInvalidFlag); !ok {
+					t.Errorf("Expected a registration error, but received %T", lg.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Cross_Flag_Validators(t *testing.T) {
+	errMissingKey := errors.New("--tls-key is required when --tls-cert is provided")
+	requireKey := func(set core.FlagSet) error {
+		if set.Lookup("tls-cert").IsSet() && !set.Lookup("tls-key").IsSet() {
+			return errMissingKey
+		}
+		return nil
+	}
+	testCases := []struct {
+		title          string
+		args           []string
+		envVars        map[string]string
+		required       bool
+		validators     []core.CrossFlagValidator
+		expectedErr    error
+		expectedCalls  int
+		mustTerminate  bool
+		expectedOrigin core.Origin
+	}{
+		{
+			title:          "no validators",
+			args:           []string{"--tls-cert", "cert.pem"},
+			expectedOrigin: core.CommandLine,
+		},
+		{
+			title:          "accepted by the validator",
+			args:           []string{"--tls-cert", "cert.pem", "--tls-key", "key.pem"},
+			validators:     []core.CrossFlagValidator{requireKey},
+			expectedCalls:  1,
+			expectedOrigin: core.CommandLine,
+		},
+		{
+			title:          "rejected by the validator",
+			args:           []string{"--tls-cert", "cert.pem"},
+			validators:     []core.CrossFlagValidator{requireKey},
+			expectedErr:    errMissingKey,
+			expectedCalls:  1,
+			mustTerminate:  true,
+			expectedOrigin: core.CommandLine,
+		},
+		{
+			title:          "values resolved from the environment variables",
+			envVars:        map[string]string{"TLS_CERT": "cert.pem"},
+			validators:     []core.CrossFlagValidator{requireKey},
+			expectedErr:    errMissingKey,
+			expectedCalls:  1,
+			mustTerminate:  true,
+			expectedOrigin: core.Environment,
+		},
+		{
+			title:          "the validators will be called in order until the first failure",
+			args:           []string{"--tls-cert", "cert.pem"},
+			validators:     []core.CrossFlagValidator{func(core.FlagSet) error { return nil }, requireKey, requireKey},
+			expectedErr:    errMissingKey,
+			expectedCalls:  2,
+			mustTerminate:  true,
+			expectedOrigin: core.CommandLine,
+		},
+		{
+			title:          "the validators will not be called if a required flag is missing",
+			required:       true,
+			validators:     []core.CrossFlagValidator{requireKey},
+			expectedErr:    errors.New("--tls-cert flag is required."),
+			mustTerminate:  true,
+			expectedOrigin: core.Unset,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			var (
+				calls  int
+				origin core.Origin
+			)
+			opts := []config.Option{
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+				config.WithAutoKeys(),
+			}
+			for _, v := range tc.validators {
+				validate := v
+				opts = append(opts, config.WithValidator(func(set core.FlagSet) error {
+					calls++
+					origin = set.Provenance("tls-cert").Origin
+					return validate(set)
+				}))
+			}
+			bucket := newBucket(tc.args, env, opts...)
+			cert := bucket.String("tls-cert", "usage")
+			if tc.required {
+				cert.Required()
+			}
+			bucket.String("tls-key", "usage")
+
+			bucket.Parse()
+
+			if tm.IsTerminated != tc.mustTerminate {
+				t.Errorf("Expected IsTerminated: %v, Actual: %v", tc.mustTerminate, tm.IsTerminated)
+			}
+			if calls != tc.expectedCalls {
+				t.Errorf("Expected number of validator calls: %d, Actual: %d", tc.expectedCalls, calls)
+			}
+			if tc.expectedErr == nil && lg.Error != nil {
+				t.Errorf("Expected no error, but received %v", lg.Error)
+			}
+			if tc.expectedErr != nil && !test.ErrorContainsExact(lg.Error, tc.expectedErr.Error()) {
+				t.Errorf("Expected '%v', but received %v", tc.expectedErr, lg.Error)
+			}
+			if calls > 0 && origin != tc.expectedOrigin {
+				t.Errorf("Expected the provenance of --tls-cert to be %v, Actual: %v", tc.expectedOrigin, origin)
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_From_File(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flags-from-file-test-")
+	if err != nil {
+		t.Fatalf("Failed to create the temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	cert := filepath.Join(dir, "cert.pem")
+	if err := ioutil.WriteFile(cert, []byte("certificate\n"), 0600); err != nil {
+		t.Fatalf("Failed to create the temporary file: %s", err)
+	}
+	port := filepath.Join(dir, "port")
+	if err := ioutil.WriteFile(port, []byte("8080\n"), 0600); err != nil {
+		t.Fatalf("Failed to create the temporary file: %s", err)
+	}
+
+	testCases := []struct {
+		title              string
+		args               []string
+		envVars            map[string]string
+		stdin              string
+		fromFile           bool
+		options            []core.FileContentOption
+		expectedCert       string
+		expectedPort       int
+		expectedProvenance string
+		expectedErr        string
+		mustTerminate      bool
+	}{
+		{
+			title:              "disabled",
+			args:               []string{"--cert=@" + cert},
+			expectedCert:       "@" + cert,
+			expectedProvenance: "@" + cert,
+		},
+		{
+			title:              "file content",
+			args:               []string{"--cert", "@" + cert},
+			fromFile:           true,
+			expectedCert:       "certificate\n",
+			expectedProvenance: "@" + cert,
+		},
+		{
+			title:              "trimmed file content",
+			args:               []string{"--cert", "@" + cert},
+			fromFile:           true,
+			options:            []core.FileContentOption{core.TrimFileContent()},
+			expectedCert:       "certificate",
+			expectedProvenance: "@" + cert,
+		},
+		{
+			title:              "stdin content",
+			args:               []string{"--cert", "-"},
+			stdin:              "from stdin",
+			fromFile:           true,
+			expectedCert:       "from stdin",
+			expectedProvenance: "-",
+		},
+		{
+			title:              "file path from the environment variables",
+			envVars:            map[string]string{"CERT": "@" + cert},
+			fromFile:           true,
+			options:            []core.FileContentOption{core.TrimFileContent()},
+			expectedCert:       "certificate",
+			expectedProvenance: "@" + cert,
+		},
+		{
+			title:              "non string flag",
+			args:               []string{"--cert", "@" + cert, "--port", "@" + port},
+			fromFile:           true,
+			options:            []core.FileContentOption{core.TrimFileContent()},
+			expectedCert:       "certificate",
+			expectedPort:       8080,
+			expectedProvenance: "@" + cert,
+		},
+		{
+			title:         "file content exceeding the size limit",
+			args:          []string{"--cert", "@" + cert},
+			fromFile:      true,
+			options:       []core.FileContentOption{core.WithMaxFileSize(5)},
+			expectedErr:   cert + " is too large to be used as the value of --cert. The maximum size is 5B.",
+			mustTerminate: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			bucket := newBucket(tc.args, env,
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+				config.WithAutoKeys())
+			bucket.stdin = strings.NewReader(tc.stdin)
+			c := bucket.String("cert", "usage")
+			p := bucket.Int("port", "usage")
+			if tc.fromFile {
+				bucket.FromFile("cert", tc.options...)
+				bucket.FromFile("port", tc.options...)
+			}
+
+			bucket.Parse()
+
+			if tm.IsTerminated != tc.mustTerminate {
+				t.Errorf("Expected IsTerminated: %v, Actual: %v", tc.mustTerminate, tm.IsTerminated)
+			}
+			if !test.ErrorContainsExact(lg.Error, tc.expectedErr) {
+				t.Errorf("Expected '%s', but received %v", tc.expectedErr, lg.Error)
+			}
+			if tc.mustTerminate {
+				return
+			}
+			if c.Get() != tc.expectedCert {
+				t.Errorf("Expected --cert value: %q, Actual: %q", tc.expectedCert, c.Get())
+			}
+			if p.Get() != tc.expectedPort {
+				t.Errorf("Expected --port value: %d, Actual: %d", tc.expectedPort, p.Get())
+			}
+			if actual := bucket.Provenance("cert").Value; actual != tc.expectedProvenance {
+				t.Errorf("Expected the provenance value: %q, Actual: %q", tc.expectedProvenance, actual)
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_From_File_Unknown_Flag(t *testing.T) {
+	lg := &mocks.Logger{}
+	tm := &mocks.Terminator{}
+	bucket := newBucket([]string{}, mocks.NewEnvReader(),
+		config.WithHelpWriter(mocks.NewInMemoryWriter()),
+		config.WithLogger(lg),
+		config.WithTerminator(tm))
+	bucket.String("cert", "usage")
+	bucket.FromFile("certificate")
+	bucket.Parse()
+
+	if !tm.IsTerminated {
+		t.Error("Expected to terminate, but it did not happen")
+	}
+	expected := "--certificate cannot be read from a file. The flag does not exist"
+	if !test.ErrorContainsExact(lg.Error, expected) {
+		t.Errorf("Expected '%s', but received %v", expected, lg.Error)
+	}
+	if _, ok := lg.Error.(*core.ErrInvalidFlag); !ok {
+		t.Errorf("Expected a registration error, but received %T", lg.Error)
+	}
+}
+
+func TestBucket_Parse_From_File_Forwarded(t *testing.T) {
+	lg := &mocks.Logger{}
+	tm := &mocks.Terminator{}
+	bucket := newBucket([]string{"--old-cert", "-"}, mocks.NewEnvReader(),
+		config.WithHelpWriter(mocks.NewInMemoryWriter()),
+		config.WithLogger(lg),
+		config.WithTerminator(tm))
+	bucket.stdin = strings.NewReader("certificate")
+	bucket.String("old-cert", "usage").MarkAsDeprecated(core.WithReplacement("cert"), core.ForwardValue())
+	c := bucket.String("cert", "usage")
+	bucket.FromFile("old-cert")
+	bucket.Parse()
+
+	if tm.IsTerminated {
+		t.Fatalf("Did not expect to terminate, but it happened with %v", lg.Error)
+	}
+	if c.Get() != "certificate" {
+		t.Errorf("Expected --cert value: %q, Actual: %q", "certificate", c.Get())
+	}
+	if actual := bucket.Provenance("cert").Value; actual != "-" {
+		t.Errorf("Expected the provenance value: %q, Actual: %q", "-", actual)
+	}
+}
+
 func TestBucket_Parse_Help_Request(t *testing.T) {
 	testCases := []struct {
 		title                   string
@@ -270,6 +787,169 @@ func TestBucket_Parse_Help_Request(t *testing.T) {
 	}
 }
 
+func TestBucket_Parse_Version_Request(t *testing.T) {
+	testCases := []struct {
+		title                   string
+		args                    []string
+		flags                   []core.Flag
+		version                 string
+		commit                  string
+		dirty                   bool
+		enableVersion           bool
+		template                string
+		expectedOutput          string
+		expectedErr             string
+		mustTerminate           bool
+		expectedTerminationCode int
+	}{
+		{
+			title:                   "version flag is not enabled",
+			args:                    []string{"--version"},
+			expectedErr:             "--version is an unknown flag",
+			mustTerminate:           true,
+			expectedTerminationCode: core.FailureExitCode,
+		},
+		{
+			title:         "user defined version flag when the built-in flag is not enabled",
+			args:          []string{"--version", "1.0"},
+			flags:         []core.Flag{mocks.NewFlag("version", "V")},
+			mustTerminate: false,
+		},
+		{
+			title:         "version flag is enabled but not requested",
+			args:          []string{"--flag", "value"},
+			flags:         []core.Flag{mocks.NewFlag("flag", "f")},
+			enableVersion: true,
+			version:       "1.0.0",
+			mustTerminate: false,
+		},
+		{
+			title:                   "version requested with long flag",
+			args:                    []string{"--version"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			expectedOutput:          "tool version 1.0.0\n",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "version requested with short flag",
+			args:                    []string{"-V"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			expectedOutput:          "tool version 1.0.0\n",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "version with commit",
+			args:                    []string{"--version"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			commit:                  "abc",
+			expectedOutput:          "tool version 1.0.0 (abc)\n",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "version with commit and local modifications",
+			args:                    []string{"--version"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			commit:                  "abc",
+			dirty:                   true,
+			expectedOutput:          "tool version 1.0.0 (abc, modified)\n",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "custom template",
+			args:                    []string{"--version"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			template:                "v{{.Version}}",
+			expectedOutput:          "v1.0.0",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "invalid template",
+			args:                    []string{"--version"},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			template:                "{{.Version",
+			expectedErr:             "unclosed action",
+			mustTerminate:           true,
+			expectedTerminationCode: core.FailureExitCode,
+		},
+		{
+			title:                   "help takes priority over version",
+			args:                    []string{"--version", "--help"},
+			flags:                   []core.Flag{mocks.NewFlag("flag", "f")},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			expectedOutput:          "--flag",
+			mustTerminate:           true,
+			expectedTerminationCode: core.SuccessExitCode,
+		},
+		{
+			title:                   "reserved version flag",
+			args:                    []string{},
+			flags:                   []core.Flag{mocks.NewFlag("version", "")},
+			enableVersion:           true,
+			version:                 "1.0.0",
+			expectedErr:             "--version is a reserved flag",
+			mustTerminate:           true,
+			expectedTerminationCode: core.FailureExitCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			w := mocks.NewInMemoryWriter()
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			opts := []config.Option{
+				config.WithHelpWriter(w),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+				config.WithProgramName("tool"),
+			}
+			if tc.template != "" {
+				opts = append(opts, config.WithVersionTemplate(tc.template))
+			}
+			bucket := newBucket(tc.args, env, opts...)
+			bucket.flags = append(bucket.flags, tc.flags...)
+
+			if tc.enableVersion {
+				info := bucket.Version(tc.version)
+				info.Commit = tc.commit
+				info.Dirty = tc.dirty
+			}
+
+			bucket.Parse()
+
+			if tm.IsTerminated != tc.mustTerminate {
+				t.Errorf("Expected IsTerminated: %v, Actual: %v", tc.mustTerminate, tm.IsTerminated)
+			}
+
+			if tm.Code != tc.expectedTerminationCode {
+				t.Errorf("Expected termination code: %d, Actual: %d", tc.expectedTerminationCode, tm.Code)
+			}
+
+			if !test.ErrorContains(lg.Error, tc.expectedErr) {
+				t.Errorf("Expected '%v', but received %v", tc.expectedErr, lg.Error)
+			}
+
+			actual := strings.Join(w.Lines, "")
+			if !strings.Contains(actual, tc.expectedOutput) {
+				t.Errorf("Expected the output to contain '%s', Actual: '%s'", tc.expectedOutput, actual)
+			}
+		})
+	}
+}
+
 func TestBucket_Parse_Help_Sort(t *testing.T) {
 	testCases := []struct {
 		title         string
@@ -292,6 +972,54 @@ func TestBucket_Parse_Help_Sort(t *testing.T) {
 			flags:         []core.Flag{mocks.NewFlag("a-long", "a"), mocks.NewFlag("x-long", "x")},
 			expectedLines: []string{"a-long", "x-long"},
 		},
+		{
+			title:    "chained comparers",
+			args:     []string{"--help"},
+			comparer: by.Chain(by.RequiredFirst, by.LongNameAscending),
+			flags: []core.Flag{
+				mocks.NewFlag("x-long", "x"),
+				mocks.NewFlag("b-long", "b").Required(),
+				mocks.NewFlag("a-long", "a"),
+				mocks.NewFlag("c-long", "c").Required(),
+			},
+			expectedLines: []string{"b-long", "c-long", "a-long", "x-long"},
+		},
+		{
+			title:    "declaration order as the tiebreaker",
+			args:     []string{"--help"},
+			comparer: by.TypeAscending,
+			flags: []core.Flag{
+				mocks.NewFlag("x-long", "x"),
+				mocks.NewFlag("b-long", "b"),
+				mocks.NewFlag("a-long", "a"),
+				mocks.NewFlag("c-long", "c"),
+			},
+			expectedLines: []string{"x-long", "b-long", "a-long", "c-long"},
+		},
+		{
+			title:    "declaration order as the tiebreaker of a boolean comparer",
+			args:     []string{"--help"},
+			comparer: by.RequiredFirst,
+			flags: []core.Flag{
+				mocks.NewFlag("a-long", "a").Required(),
+				mocks.NewFlag("b-long", "b").Required(),
+				mocks.NewFlag("c-long", "c"),
+				mocks.NewFlag("d-long", "d").Required(),
+			},
+			expectedLines: []string{"a-long", "b-long", "d-long", "c-long"},
+		},
+		{
+			title:    "declaration order as the tiebreaker of a descending boolean comparer",
+			args:     []string{"--help"},
+			comparer: by.RequiredLast,
+			flags: []core.Flag{
+				mocks.NewFlag("a-long", "a"),
+				mocks.NewFlag("b-long", "b").Required(),
+				mocks.NewFlag("c-long", "c"),
+				mocks.NewFlag("d-long", "d"),
+			},
+			expectedLines: []string{"a-long", "c-long", "d-long", "b-long"},
+		},
 		{
 			title:         "sort by long name ascending",
 			args:          []string{"--help"},
@@ -404,14 +1132,371 @@ func TestBucket_Parse_Help_Sort(t *testing.T) {
 				t.Errorf("Expected termination code: %d, Actual: %d", core.SuccessExitCode, tm.Code)
 			}
 
-			if w.WriteCounter != 2 {
-				t.Errorf("Expectced to call Help() for 2 flags, Actual: %d", w.WriteCounter)
+			if w.WriteCounter != len(tc.expectedLines) {
+				t.Errorf("Expectced to call Help() for %d flags, Actual: %d", len(tc.expectedLines), w.WriteCounter)
+			}
+
+			for i, line := range w.Lines {
+				if !strings.Contains(line, tc.expectedLines[i]) {
+					t.Errorf("Expectced the Help line %d to contain '%s', Actual %s", i+1, tc.expectedLines[i], line)
+				}
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Help_Sections(t *testing.T) {
+	testCases := []struct {
+		title    string
+		opts     []config.Option
+		expected string
+	}{
+		{
+			title:    "no sections",
+			expected: "-p,  --port  int      Port\n",
+		},
+		{
+			title: "default usage with description",
+			opts:  []config.Option{config.WithDescription("Does things.")},
+			expected: "Usage: tool [flags]\n\n" +
+				"Does things.\n\n" +
+				"Flags:\n" +
+				"-p,  --port  int      Port\n",
+		},
+		{
+			title: "all sections",
+			opts: []config.Option{
+				config.WithSynopsis("tool [flags] <file>"),
+				config.WithDescription("Does things."),
+				config.WithExample("tool -p 80 a.txt", "Reads a.txt"),
+				config.WithExample("tool b.txt", ""),
+				config.WithFooter("See the docs."),
+			},
+			expected: "Usage: tool [flags] <file>\n\n" +
+				"Does things.\n\n" +
+				"Flags:\n" +
+				"-p,  --port  int      Port\n\n" +
+				"Examples:\n" +
+				"  tool -p 80 a.txt\n" +
+				"      Reads a.txt\n" +
+				"  tool b.txt\n\n" +
+				"See the docs.\n",
+		},
+		{
+			title: "footer only",
+			opts:  []config.Option{config.WithFooter("See the docs.")},
+			expected: "Usage: tool [flags]\n\n" +
+				"Flags:\n" +
+				"-p,  --port  int      Port\n\n" +
+				"See the docs.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			opts := append([]config.Option{
+				config.WithProgramName("tool"),
+				config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+			}, tc.opts...)
+			bucket := newBucket([]string{"--help"}, mocks.NewEnvReader(), opts...)
+			bucket.Int("port", "Port").WithShort("p")
+			bucket.Parse()
+
+			if !tm.IsTerminated || tm.Code != core.SuccessExitCode {
+				t.Fatalf("Expected to terminate with success, but it did not happen: %v", lg.Error)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("Expected:\n%s\nActual:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Help_Terminal_Width(t *testing.T) {
+	testCases := []struct {
+		title    string
+		envVars  map[string]string
+		expected int
+	}{
+		{
+			title: "no COLUMNS variable",
+		},
+		{
+			title:    "COLUMNS variable",
+			envVars:  map[string]string{"COLUMNS": "120"},
+			expected: 120,
+		},
+		{
+			title:   "invalid COLUMNS variable",
+			envVars: map[string]string{"COLUMNS": "wide"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			w := mocks.NewInMemoryWriter()
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			bucket := newBucket([]string{"--help"}, env,
+				config.WithHelpWriter(w),
+				config.WithLogger(&mocks.Logger{}),
+				config.WithTerminator(tm))
+			bucket.Int("port", "Port")
+			bucket.Parse()
+
+			if w.TerminalWidth != tc.expected {
+				t.Errorf("Expected terminal width: %d, Actual: %d", tc.expected, w.TerminalWidth)
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Help_Groups(t *testing.T) {
+	testCases := []struct {
+		title    string
+		opts     []config.Option
+		grouped  bool
+		expected string
+	}{
+		{
+			title:    "no groups",
+			expected: "--port int\n--name string\n--log bool\n--host string\n",
+		},
+		{
+			title:   "default group order",
+			grouped: true,
+			expected: "General:\n--name string\n--log bool\n\n" +
+				"Networking:\n--port int\n--host string\n",
+		},
+		{
+			title:   "custom default group title and order",
+			grouped: true,
+			opts:    []config.Option{config.WithDefaultGroupTitle("Other"), config.WithGroupOrder("Networking", "Other")},
+			expected: "Networking:\n--port int\n--host string\n\n" +
+				"Other:\n--name string\n--log bool\n",
+		},
+		{
+			title:   "per group sort order",
+			grouped: true,
+			opts: []config.Option{
+				config.WithSortOrder(by.LongNameDescending),
+				config.WithGroupSortOrder("Networking", by.LongNameAscending),
+			},
+			expected: "General:\n--name string\n--log bool\n\n" +
+				"Networking:\n--host string\n--port int\n",
+		},
+		{
+			title:   "per group sort order with white space",
+			grouped: true,
+			opts: []config.Option{
+				config.WithSortOrder(by.LongNameDescending),
+				config.WithGroupSortOrder(" Networking ", by.LongNameAscending),
+			},
+			expected: "General:\n--name string\n--log bool\n\n" +
+				"Networking:\n--host string\n--port int\n",
+		},
+		{
+			title:   "english default group title in the group order of a translated bucket",
+			grouped: true,
+			opts: []config.Option{
+				config.WithMessageCatalogue(core.MessageMap{core.MsgDefaultGroupTitle: "Allgemein"}),
+				config.WithGroupOrder("Networking", "General"),
+			},
+			expected: "Networking:\n--port int\n--host string\n\n" +
+				"Allgemein:\n--name string\n--log bool\n",
+		},
+		{
+			title:   "english default group title in the sort order of a translated bucket",
+			grouped: true,
+			opts: []config.Option{
+				config.WithMessageCatalogue(core.MessageMap{core.MsgDefaultGroupTitle: "Allgemein"}),
+				config.WithGroupSortOrder("General", by.LongNameAscending),
+			},
+			expected: "Allgemein:\n--log bool\n--name string\n\n" +
+				"Networking:\n--port int\n--host string\n",
+		},
+		{
+			title:   "with header",
+			grouped: true,
+			opts:    []config.Option{config.WithDescription("Does things.")},
+			expected: "Usage: tool [flags]\n\nDoes things.\n\n" +
+				"General:\n--name string\n--log bool\n\n" +
+				"Networking:\n--port int\n--host string\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			opts := append([]config.Option{
+				config.WithProgramName("tool"),
+				config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+			}, tc.opts...)
+			bucket := newBucket([]string{"--help"}, mocks.NewEnvReader(), opts...)
+			port := bucket.Int("port", "")
+			bucket.String("name", "")
+			bucket.Bool("log", "")
+			host := bucket.String("host", "")
+			bucket.String("secret", "").WithGroup("Secrets").Hide()
+			if tc.grouped {
+				port.WithGroup("Networking")
+				host.WithGroup(" Networking ")
+			}
+			bucket.Parse()
+
+			if !tm.IsTerminated || tm.Code != core.SuccessExitCode {
+				t.Fatalf("Expected to terminate with success, but it did not happen: %v", lg.Error)
+			}
+			actual := collapseSpaces(buf.String())
+			if actual != tc.expected {
+				t.Errorf("Expected:\n%s\nActual:\n%s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// collapseSpaces removes the tabbed alignment padding from each line of the help output.
+func collapseSpaces(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestBucket_Parse_Help_Keys(t *testing.T) {
+	testCases := []struct {
+		title    string
+		opts     []config.Option
+		expected string
+	}{
+		{
+			title:    "default key format",
+			expected: "--port [$APP_PORT] int Port\n--name [$APP_NAME] string Name\n--token string Token\n",
+		},
+		{
+			title:    "custom key format",
+			opts:     []config.Option{config.WithKeyFormatString("env:%s")},
+			expected: "--port env:APP_PORT int Port\n--name env:APP_NAME string Name\n--token string Token\n",
+		},
+		{
+			title:    "with environment presence mark",
+			opts:     []config.Option{config.WithEnvPresenceMark("(set)")},
+			expected: "--port [$APP_PORT] (set) int Port\n--name [$APP_NAME] string Name\n--token string Token\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			env := mocks.NewEnvReader()
+			env.Set("APP_PORT", "secret")
+			opts := append([]config.Option{
+				config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+				config.WithLogger(&mocks.Logger{}),
+				config.WithTerminator(&mocks.Terminator{}),
+				config.WithKeyPrefix("app"),
+				config.WithAutoKeys(),
+			}, tc.opts...)
+			bucket := newBucket([]string{"--help"}, env, opts...)
+			bucket.Int("port", "Port")
+			bucket.String("name", "Name")
+			bucket.String("token", "Token").WithKey("-")
+			bucket.Parse()
+
+			actual := collapseSpaces(buf.String())
+			if actual != tc.expected {
+				t.Errorf("Expected:\n%s\nActual:\n%s", tc.expected, actual)
+			}
+			if strings.Contains(actual, "secret") {
+				t.Errorf("The environment variable value must not be printed")
+			}
+		})
+	}
+}
+
+type terminalWriter struct {
+	*bytes.Buffer
+	isTerminal bool
+}
+
+func (w *terminalWriter) Close() error {
+	return nil
+}
+
+func (w *terminalWriter) IsTerminal() bool {
+	return w.isTerminal
+}
+
+func TestBucket_Parse_Help_Theme(t *testing.T) {
+	testCases := []struct {
+		title        string
+		isTerminal   bool
+		noColor      string
+		setNoColor   bool
+		theme        *core.Theme
+		expectStyled bool
+	}{
+		{
+			title:        "terminal output",
+			isTerminal:   true,
+			theme:        core.DefaultTheme(),
+			expectStyled: true,
+		},
+		{
+			title: "non terminal output",
+			theme: core.DefaultTheme(),
+		},
+		{
+			title:      "NO_COLOR is set",
+			isTerminal: true,
+			setNoColor: true,
+			noColor:    "1",
+			theme:      core.DefaultTheme(),
+		},
+		{
+			title:        "empty NO_COLOR",
+			isTerminal:   true,
+			setNoColor:   true,
+			theme:        core.DefaultTheme(),
+			expectStyled: true,
+		},
+		{
+			title:      "nil theme",
+			isTerminal: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			w := &terminalWriter{Buffer: &bytes.Buffer{}, isTerminal: tc.isTerminal}
+			env := mocks.NewEnvReader()
+			if tc.setNoColor {
+				env.Set("NO_COLOR", tc.noColor)
 			}
+			bucket := newBucket([]string{"--help"}, env,
+				config.WithHelpWriter(w),
+				config.WithLogger(&mocks.Logger{}),
+				config.WithTerminator(&mocks.Terminator{}),
+				config.WithTheme(tc.theme))
+			bucket.Int("port", "Port").Required()
+			bucket.Parse()
 
-			for i, line := range w.Lines {
-				if !strings.Contains(line, tc.expectedLines[i]) {
-					t.Errorf("Expectced the Help line %d to contain '%s', Actual %s", i+1, tc.expectedLines[i], line)
-				}
+			actual := strings.Contains(w.String(), "\x1b[")
+			if actual != tc.expectStyled {
+				t.Errorf("Expected styled output: %v, Actual: %q", tc.expectStyled, w.String())
 			}
 		})
 	}
@@ -2257,6 +3342,32 @@ func TestBucket_DurationSlice(t *testing.T) {
 	}
 }
 
+func TestBucket_ByteSize(t *testing.T) {
+	bucket := NewBucket()
+	bucket.ByteSize("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.ByteSizeFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.ByteSizeFlag{}, f)
+	}
+}
+
+func TestBucket_ByteSizeSlice(t *testing.T) {
+	bucket := NewBucket()
+	bucket.ByteSizeSlice("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.ByteSizeSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.ByteSizeSliceFlag{}, f)
+	}
+}
+
 func TestBucket_Time(t *testing.T) {
 	bucket := NewBucket()
 	bucket.Time("long", "usage")
@@ -2348,6 +3459,84 @@ func TestBucket_IPAddressSlice(t *testing.T) {
 	}
 }
 
+func TestBucket_URL(t *testing.T) {
+	bucket := NewBucket()
+	bucket.URL("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.URLFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.URLFlag{}, f)
+	}
+}
+
+func TestBucket_URLSlice(t *testing.T) {
+	bucket := NewBucket()
+	bucket.URLSlice("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.URLSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.URLSliceFlag{}, f)
+	}
+}
+
+func TestBucket_FilePath(t *testing.T) {
+	bucket := NewBucket()
+	bucket.FilePath("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.FilePathFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.FilePathFlag{}, f)
+	}
+}
+
+func TestBucket_DirPath(t *testing.T) {
+	bucket := NewBucket()
+	bucket.DirPath("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.DirPathFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.DirPathFlag{}, f)
+	}
+}
+
+func TestBucket_Endpoint(t *testing.T) {
+	bucket := NewBucket()
+	bucket.Endpoint("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.EndpointFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.EndpointFlag{}, f)
+	}
+}
+
+func TestBucket_EndpointSlice(t *testing.T) {
+	bucket := NewBucket()
+	bucket.EndpointSlice("long", "usage")
+	actual := len(bucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := bucket.Flags()[0]
+	if _, ok := f.(*core.EndpointSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.EndpointSliceFlag{}, f)
+	}
+}
+
 func TestBucket_CIDR(t *testing.T) {
 	bucket := NewBucket()
 	bucket.CIDR("long", "usage")
@@ -2389,3 +3578,572 @@ func testTermination(t *testing.T, mustTerminate, isTerminated bool, expectedCod
 		t.Errorf("Did not expect to terminate, but the app was terminated")
 	}
 }
+
+func TestBucket_Provenance(t *testing.T) {
+	testCases := []struct {
+		title           string
+		args            []string
+		envVars         map[string]string
+		sourceValues    map[string]string
+		flags           []core.Flag
+		lookup          string
+		expectedOrigin  core.Origin
+		expectedName    string
+		expectedValue   string
+		expectedString  string
+		expectNil       bool
+		expectSourceNil bool
+	}{
+		{
+			title:          "long name on the command line",
+			args:           []string{"--port", "80"},
+			flags:          []core.Flag{core.NewInt("port", "").WithShort("p")},
+			lookup:         "port",
+			expectedOrigin: core.CommandLine,
+			expectedName:   "--port",
+			expectedValue:  "80",
+			expectedString: "command line (--port)",
+		},
+		{
+			title:          "short name on the command line",
+			args:           []string{"-p", "80"},
+			flags:          []core.Flag{core.NewInt("port", "").WithShort("p")},
+			lookup:         "port",
+			expectedOrigin: core.CommandLine,
+			expectedName:   "-p",
+			expectedValue:  "80",
+			expectedString: "command line (-p)",
+		},
+		{
+			title:          "environment variable",
+			envVars:        map[string]string{"PORT": "80"},
+			flags:          []core.Flag{core.NewInt("port", "").WithKey("port")},
+			lookup:         "port",
+			expectedOrigin: core.Environment,
+			expectedName:   "PORT",
+			expectedValue:  "80",
+			expectedString: "environment ($PORT)",
+		},
+		{
+			title:          "custom source",
+			sourceValues:   map[string]string{"PORT": "80"},
+			flags:          []core.Flag{core.NewInt("port", "").WithKey("port")},
+			lookup:         "port",
+			expectedOrigin: core.CustomSource,
+			expectedName:   "PORT",
+			expectedValue:  "80",
+			expectedString: "source (PORT)",
+		},
+		{
+			title:           "default value",
+			flags:           []core.Flag{core.NewInt("port", "").WithDefault(80)},
+			lookup:          "port",
+			expectedOrigin:  core.DefaultValue,
+			expectedString:  "default",
+			expectSourceNil: true,
+		},
+		{
+			title:           "unset",
+			flags:           []core.Flag{core.NewInt("port", "")},
+			lookup:          "port",
+			expectedOrigin:  core.Unset,
+			expectedString:  "unset",
+			expectSourceNil: true,
+		},
+		{
+			title: "forwarded from a deprecated flag",
+			args:  []string{"--old", "value"},
+			flags: []core.Flag{
+				core.NewString("old", "").MarkAsDeprecated(core.WithReplacement("new"), core.ForwardValue()),
+				core.NewString("new", ""),
+			},
+			lookup:          "new",
+			expectedOrigin:  core.Forwarded,
+			expectedName:    "old",
+			expectedValue:   "value",
+			expectedString:  "forwarded (--old)",
+			expectSourceNil: true,
+		},
+		{
+			title:     "unknown flag",
+			flags:     []core.Flag{core.NewInt("port", "")},
+			lookup:    "unknown",
+			expectNil: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			bucket := newBucket(tc.args, env,
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(&mocks.Logger{}),
+				config.WithTerminator(&mocks.Terminator{}))
+			if tc.sourceValues != nil {
+				src := NewMemorySource()
+				src.AddRange(tc.sourceValues)
+				bucket.AppendSource(src)
+			}
+			bucket.flags = append(bucket.flags, tc.flags...)
+			bucket.Parse()
+
+			actual := bucket.Provenance(tc.lookup)
+			if tc.expectNil {
+				if actual != nil {
+					t.Errorf("Expected nil provenance, Actual: %v", actual)
+				}
+				return
+			}
+			if actual == nil {
+				t.Fatal("Expected provenance, but received nil")
+			}
+			if actual.Origin != tc.expectedOrigin {
+				t.Errorf("Expected Origin: %v, Actual: %v", tc.expectedOrigin, actual.Origin)
+			}
+			if actual.Name != tc.expectedName {
+				t.Errorf("Expected Name: %q, Actual: %q", tc.expectedName, actual.Name)
+			}
+			if actual.Value != tc.expectedValue {
+				t.Errorf("Expected Value: %q, Actual: %q", tc.expectedValue, actual.Value)
+			}
+			if actual.String() != tc.expectedString {
+				t.Errorf("Expected String: %q, Actual: %q", tc.expectedString, actual.String())
+			}
+			if (actual.Source == nil) != tc.expectSourceNil {
+				t.Errorf("Expected nil Source: %v, Actual: %v", tc.expectSourceNil, actual.Source)
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Help_Flag(t *testing.T) {
+	testCases := []struct {
+		title         string
+		args          []string
+		envVars       map[string]string
+		expected      string
+		expectedError string
+	}{
+		{
+			title:   "long help flag",
+			args:    []string{"--help=port"},
+			envVars: map[string]string{"PORT": "8080"},
+			expected: "-p, --port int\n" +
+				"  Port number\n" +
+				"  The port on which the server listens.\n\n" +
+				"  Privileged ports require root access.\n\n" +
+				"Examples:\n" +
+				"  tool --port 8080\n\n" +
+				"Environment:    [$PORT]\n" +
+				"Default:        80\n" +
+				"Valid values:   80, 8080\n" +
+				"Group:          Network\n" +
+				"Current value:  8080\n" +
+				"Source:         environment ($PORT)\n",
+		},
+		{
+			title: "help command with short name",
+			args:  []string{"help", "p", "--port", "8080"},
+			expected: "-p, --port int\n" +
+				"  Port number\n" +
+				"  The port on which the server listens.\n\n" +
+				"  Privileged ports require root access.\n\n" +
+				"Examples:\n" +
+				"  tool --port 8080\n\n" +
+				"Environment:    [$PORT]\n" +
+				"Default:        80\n" +
+				"Valid values:   80, 8080\n" +
+				"Group:          Network\n" +
+				"Current value:  8080\n" +
+				"Source:         command line (--port)\n",
+		},
+		{
+			title: "default value",
+			args:  []string{"help", "port"},
+			expected: "-p, --port int\n" +
+				"  Port number\n" +
+				"  The port on which the server listens.\n\n" +
+				"  Privileged ports require root access.\n\n" +
+				"Examples:\n" +
+				"  tool --port 8080\n\n" +
+				"Environment:    [$PORT]\n" +
+				"Default:        80\n" +
+				"Valid values:   80, 8080\n" +
+				"Group:          Network\n" +
+				"Current value:  80\n" +
+				"Source:         default\n",
+		},
+		{
+			title:         "hidden flag without help all",
+			args:          []string{"--help=secret"},
+			expectedError: "--secret is an unknown flag",
+		},
+		{
+			title: "hidden and deprecated flag",
+			args:  []string{"--help-all=secret"},
+			expected: "--secret string*\n" +
+				"  Secret\n\n" +
+				"Environment:    [$SECRET]\n" +
+				"Status:         required, deprecated, hidden\n" +
+				"Deprecated:     [DEPRECATED] Use --port instead.\n" +
+				"Current value:  \n" +
+				"Source:         unset\n",
+		},
+		{
+			title:         "unknown flag",
+			args:          []string{"--help=unknown"},
+			expectedError: "--unknown is an unknown flag",
+		},
+		{
+			title: "reveal hidden flags",
+			args:  []string{"--help-all"},
+			expected: "General:\n" +
+				"  --secret  [$SECRET]  string*      Secret [DEPRECATED]\n\n" +
+				"Network:\n" +
+				"-p,  --port  [$PORT]  int      Port number (default: 80)\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			env := mocks.NewEnvReader()
+			for key, value := range tc.envVars {
+				env.Set(key, value)
+			}
+			bucket := newBucket(tc.args, env,
+				config.WithProgramName("tool"),
+				config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+				config.WithLogger(lg),
+				config.WithTerminator(tm))
+			bucket.Int("port", "Port number").
+				WithShort("p").
+				WithKey("port").
+				WithDefault(80).
+				WithValidRange(80, 8080).
+				WithGroup("Network").
+				WithDescription("The port on which the server listens.\n\nPrivileged ports require root access.").
+				WithExamples("tool --port 8080")
+			bucket.String("secret", "Secret").WithKey("secret").Hide().Required().MarkAsDeprecated(core.WithReplacement("port"))
+			bucket.Parse()
+
+			if !tm.IsTerminated {
+				t.Fatal("Expected to terminate, but it did not happen")
+			}
+			if tc.expectedError != "" {
+				if tm.Code != core.FailureExitCode || !test.ErrorContainsExact(lg.Error, tc.expectedError) {
+					t.Errorf("Expected error '%s' and failure exit code, Actual: %v, %d", tc.expectedError, lg.Error, tm.Code)
+				}
+				return
+			}
+			if tm.Code != core.SuccessExitCode {
+				t.Fatalf("Expected to terminate with success, but it did not happen: %v", lg.Error)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("Expected:\n%q\nActual:\n%q", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Help_Flag_Sensitive_Value(t *testing.T) {
+	buf := &bytes.Buffer{}
+	lg := &mocks.Logger{}
+	tm := &mocks.Terminator{}
+	bucket := newBucket([]string{"--help=endpoint", "--endpoint=https://example.com?region=eu&token=secret"}, mocks.NewEnvReader(),
+		config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+		config.WithLogger(lg),
+		config.WithTerminator(tm))
+	bucket.URL("endpoint", "Endpoint").
+		WithKey("-").
+		WithSensitiveQuery("token").
+		WithDefault(&url.URL{Scheme: "https", Host: "localhost", RawQuery: "token=default"})
+	bucket.Parse()
+
+	if !tm.IsTerminated || tm.Code != core.SuccessExitCode {
+		t.Fatalf("Expected to terminate with success, but it did not happen: %v", lg.Error)
+	}
+	expected := "--endpoint url\n" +
+		"  Endpoint\n\n" +
+		"Default:        https://localhost?token=xxxxx\n" +
+		"Current value:  https://example.com?region=eu&token=xxxxx\n" +
+		"Source:         command line (--endpoint)\n"
+	if buf.String() != expected {
+		t.Errorf("Expected help output:\n%q\nActual:\n%q", expected, buf.String())
+	}
+}
+
+func TestBucket_Parse_Localisation(t *testing.T) {
+	catalogue := core.MessageMap{
+		core.MsgRequired:           "%s ist erforderlich.",
+		core.MsgInvalidValue:       "'%v' ist kein gültiger %s Wert für %s",
+		core.MsgOutOfRangeValues:   "%v ist kein zulässiger Wert für %s. Erwartet: %s.",
+		core.MsgUnknownFlag:        "%s ist ein unbekanntes Flag",
+		core.MsgDeprecated:         "%s ist veraltet.",
+		core.MsgDefaultValue:       "(Standard: %v)",
+		core.MsgDeprecationMark:    "[VERALTET]",
+		core.MsgDefaultGroupTitle:  "Allgemein",
+		core.MsgUsageHeading:       "Verwendung:",
+		core.MsgFlagsHeading:       "Optionen:",
+		core.MsgFlagExists:         "existiert bereits",
+		core.MsgMissingReplacement: "Ersatz-Flag --%s existiert nicht",
+		core.MsgInvalidDefault:     "hat einen ungültigen Standardwert. %v",
+	}
+	testCases := []struct {
+		title           string
+		args            []string
+		flags           func(b *Bucket)
+		expectedError   string
+		expectedWarning bool
+		expectedOutput  string
+	}{
+		{
+			title: "required flag",
+			flags: func(b *Bucket) {
+				b.Int("port", "Port").Required()
+			},
+			expectedError: "--port ist erforderlich.",
+		},
+		{
+			title: "invalid value",
+			args:  []string{"--port", "abc"},
+			flags: func(b *Bucket) {
+				b.Int("port", "Port")
+			},
+			expectedError: "'abc' ist kein gültiger int Wert für --port",
+		},
+		{
+			title: "out of range",
+			args:  []string{"--port", "10"},
+			flags: func(b *Bucket) {
+				b.Int("port", "Port").WithValidRange(80, 443)
+			},
+			expectedError: "10 ist kein zulässiger Wert für --port. Erwartet: 80,443.",
+		},
+		{
+			title: "unknown flag",
+			args:  []string{"--unknown"},
+			flags: func(b *Bucket) {
+				b.Int("port", "Port")
+			},
+			expectedError: "--unknown ist ein unbekanntes Flag",
+		},
+		{
+			title: "deprecation warning",
+			args:  []string{"--old", "value"},
+			flags: func(b *Bucket) {
+				b.String("old", "Old").MarkAsDeprecated()
+			},
+			expectedError:   "--old ist veraltet.",
+			expectedWarning: true,
+		},
+		{
+			title: "duplicate flag",
+			flags: func(b *Bucket) {
+				b.Int("port", "Port")
+				b.Int("port", "Port")
+			},
+			expectedError: "--port existiert bereits",
+		},
+		{
+			title: "invalid default value",
+			flags: func(b *Bucket) {
+				b.String("format", "Format").WithValidRange(true, "json", "yaml").WithDefault("xml")
+			},
+			expectedError: "--format hat einen ungültigen Standardwert. xml ist kein zulässiger Wert für --format. Erwartet: json,yaml.",
+		},
+		{
+			title: "missing replacement",
+			flags: func(b *Bucket) {
+				b.String("old", "Old").MarkAsDeprecated(core.WithReplacement("new"))
+			},
+			expectedError: "--old Ersatz-Flag --new existiert nicht",
+		},
+		{
+			title: "help output",
+			args:  []string{"--help"},
+			flags: func(b *Bucket) {
+				b.Int("port", "Port").WithDefault(80).WithGroup("Netzwerk")
+				b.String("old", "Old").MarkAsDeprecated()
+			},
+			expectedOutput: "Verwendung: tool [flags]\n\n" +
+				"Allgemein:\n" +
+				"  --old  string      Old [VERALTET]\n\n" +
+				"Netzwerk:\n" +
+				"  --port  int      Port (Standard: 80)\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			lg := &mocks.Logger{}
+			tm := &mocks.Terminator{}
+			bucket := newBucket(tc.args, mocks.NewEnvReader(),
+				config.WithProgramName("tool"),
+				config.WithSynopsis("tool [flags]"),
+				config.WithHelpWriter(core.NewTabbedHelpWriter(buf)),
+				config.WithLogger(lg),
+				config.WithTerminator(tm),
+				config.WithMessageCatalogue(catalogue))
+			tc.flags(bucket)
+			bucket.Parse()
+
+			if tc.expectedError != "" && !test.ErrorContainsExact(lg.Error, tc.expectedError) {
+				t.Errorf("Expected '%s', Actual: %v", tc.expectedError, lg.Error)
+			}
+			if _, ok := lg.Error.(*core.DeprecationWarning); ok != tc.expectedWarning {
+				t.Errorf("Expected deprecation warning: %v, Actual: %T", tc.expectedWarning, lg.Error)
+			}
+			if tc.expectedOutput != "" && buf.String() != tc.expectedOutput {
+				t.Errorf("Expected:\n%q\nActual:\n%q", tc.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+func TestBucket_English_Defaults(t *testing.T) {
+	catalogue := core.EnglishCatalogue{}
+	defaults := map[string]string{
+		core.MsgDeprecationMark:   config.DeprecatedFlagIndicatorDefault,
+		core.MsgDefaultValue:      config.DefaultValueFormatStringDefault,
+		core.MsgDefaultGroupTitle: config.DefaultGroupTitleDefault,
+	}
+	for id, expected := range defaults {
+		if actual := catalogue.Message(id); actual != expected {
+			t.Errorf("Expected the English %s message to be %q, Actual: %q", id, expected, actual)
+		}
+	}
+}
+
+func TestBucket_Parse_Deprecation_Warning_Level(t *testing.T) {
+	testCases := []struct {
+		title          string
+		level          core.Level
+		expectedEvents []string
+	}{
+		{
+			title:          "warning level enabled",
+			level:          core.LevelWarn,
+			expectedEvents: []string{"--old is deprecated."},
+		},
+		{
+			title: "warning level disabled",
+			level: core.LevelError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.LeveledLogger{Level: tc.level}
+			tm := &mocks.Terminator{}
+			bucket := newBucket([]string{"--old", "value"}, mocks.NewEnvReader(),
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm))
+			bucket.String("old", "Old").MarkAsDeprecated()
+			bucket.Parse()
+
+			if tm.IsTerminated {
+				t.Fatalf("Did not expect to terminate, but it happened with %v", lg.Error)
+			}
+			if lg.Error != nil {
+				t.Errorf("Did not expect the warning to be printed as an error, Actual: %v", lg.Error)
+			}
+			if strings.Join(lg.Events, "\n") != strings.Join(tc.expectedEvents, "\n") {
+				t.Errorf("Expected events:\n%s\nActual:\n%s", strings.Join(tc.expectedEvents, "\n"), strings.Join(lg.Events, "\n"))
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Debug_Events(t *testing.T) {
+	testCases := []struct {
+		title          string
+		args           []string
+		enabled        bool
+		expectedEvents []string
+		expectedError  string
+		expectedLevel  core.Level
+	}{
+		{
+			title:         "log level flag not provided",
+			args:          []string{"--port", "80"},
+			enabled:       true,
+			expectedLevel: core.LevelInfo,
+		},
+		{
+			title:         "log level flag has not been enabled",
+			args:          []string{"--port", "80", "--log-level", "debug"},
+			expectedError: "--log-level is an unknown flag",
+			expectedLevel: core.LevelInfo,
+		},
+		{
+			title:         "invalid log level",
+			args:          []string{"--log-level", "verbose"},
+			enabled:       true,
+			expectedError: "'verbose' is not a valid log level value for --log-level",
+			expectedLevel: core.LevelInfo,
+		},
+		{
+			title:         "debug level",
+			args:          []string{"--port", "80", "--log-level=debug"},
+			enabled:       true,
+			expectedLevel: core.LevelDebug,
+			expectedEvents: []string{
+				"parsing flags flags=3 sources=3",
+				"source queried flag=port source=\"command line\" found=true",
+				"value resolved flag=port origin=\"command line (--port)\"",
+				"source queried flag=name source=\"command line\" found=false",
+				"source queried flag=name source=environment key=NAME found=false",
+				"source queried flag=name source=*flags.MemorySource key=NAME found=true",
+				"value resolved flag=name origin=\"source (NAME)\"",
+				"source queried flag=debug source=\"command line\" found=false",
+				"source skipped flag=debug source=environment reason=\"the flag has no key\"",
+				"source skipped flag=debug source=*flags.MemorySource reason=\"the flag has no key\"",
+				"no value provided flag=debug origin=default",
+				"parsing completed",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			lg := &mocks.LeveledLogger{}
+			tm := &mocks.Terminator{}
+			bucket := newBucket(tc.args, mocks.NewEnvReader(),
+				config.WithHelpWriter(mocks.NewInMemoryWriter()),
+				config.WithLogger(lg),
+				config.WithTerminator(tm))
+			if tc.enabled {
+				bucket.EnableLogLevelFlag()
+			}
+			src := NewMemorySource()
+			src.Add("NAME", "value")
+			bucket.AppendSource(src)
+			bucket.Int("port", "Port")
+			bucket.String("name", "Name").WithKey("name")
+			bucket.Bool("debug", "Debug").WithDefault(false)
+			bucket.Parse()
+
+			if tc.expectedError != "" {
+				if !tm.IsTerminated || !test.ErrorContainsExact(lg.Error, tc.expectedError) {
+					t.Errorf("Expected error '%s', Actual: %v", tc.expectedError, lg.Error)
+				}
+			} else if tm.IsTerminated {
+				t.Errorf("Expected no termination, but received %v", lg.Error)
+			}
+			if lg.Level != tc.expectedLevel {
+				t.Errorf("Expected level: %v, Actual: %v", tc.expectedLevel, lg.Level)
+			}
+			if strings.Join(lg.Events, "\n") != strings.Join(tc.expectedEvents, "\n") {
+				t.Errorf("Expected events:\n%s\nActual:\n%s", strings.Join(tc.expectedEvents, "\n"), strings.Join(lg.Events, "\n"))
+			}
+		})
+	}
+}
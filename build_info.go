@@ -0,0 +1,24 @@
+//go:build go1.18
+// +build go1.18
+
+package flags
+
+import "runtime/debug"
+
+func readBuildInfo(info *VersionInfo) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	info.Version = bi.Main.Version
+	for _, setting := range bi.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Commit = setting.Value
+		case "vcs.time":
+			info.Time = setting.Value
+		case "vcs.modified":
+			info.Dirty = setting.Value == "true"
+		}
+	}
+}
@@ -0,0 +1,15 @@
+//go:build !go1.18
+// +build !go1.18
+
+package flags
+
+import "runtime/debug"
+
+// The VCS information is not embedded into the binaries built by Go versions older than 1.18.
+func readBuildInfo(info *VersionInfo) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	info.Version = bi.Main.Version
+}
@@ -14,6 +14,8 @@ const (
 	IsRequired BooleanComparisonField = iota
 	// IsDeprecated the flag's deprecation status.
 	IsDeprecated
+	// IsHidden the flag's visibility status.
+	IsHidden
 )
 
 // BooleanComparer represents an implementation of the by.Comparer interface to compare boolean values.
@@ -42,6 +44,8 @@ func (b BooleanComparer) getBooleanFieldToCompare(f core.Flag) bool {
 		return f.IsRequired()
 	case IsDeprecated:
 		return f.IsDeprecated()
+	case IsHidden:
+		return f.IsHidden()
 	default:
 		return false
 	}
@@ -236,6 +236,31 @@ func TestBooleanComparer_LessThan(t *testing.T) {
 			f1:               mocks.NewFlag("long", "s"),
 			expectedLessThan: false,
 		},
+		// IsHidden
+		{
+			title:            "hidden f1 ascending",
+			isAscending:      true,
+			field:            by.IsHidden,
+			f2:               mocks.NewFlag("long", "s"),
+			f1:               hiddenFlag("long", "s"),
+			expectedLessThan: true,
+		},
+		{
+			title:            "hidden f1 descending",
+			isAscending:      false,
+			field:            by.IsHidden,
+			f2:               mocks.NewFlag("long", "s"),
+			f1:               hiddenFlag("long", "s"),
+			expectedLessThan: false,
+		},
+		{
+			title:            "hidden f2 descending",
+			isAscending:      false,
+			field:            by.IsHidden,
+			f2:               hiddenFlag("long", "s"),
+			f1:               mocks.NewFlag("long", "s"),
+			expectedLessThan: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -251,3 +276,9 @@ func TestBooleanComparer_LessThan(t *testing.T) {
 		})
 	}
 }
+
+func hiddenFlag(long, short string) *mocks.Flag {
+	f := mocks.NewFlag(long, short)
+	f.SetHidden(true)
+	return f
+}
@@ -28,4 +28,18 @@ var (
 	DeprecatedFirst = BooleanComparer{Ascending: true, Field: IsDeprecated}
 	// DeprecatedLast put the deprecated flags at the end.
 	DeprecatedLast = BooleanComparer{Ascending: false, Field: IsDeprecated}
+	// HiddenFirst put the hidden flags first.
+	HiddenFirst = BooleanComparer{Ascending: true, Field: IsHidden}
+	// HiddenLast put the hidden flags at the end.
+	HiddenLast = BooleanComparer{Ascending: false, Field: IsHidden}
+	// TypeAscending sort by type in ascending order.
+	TypeAscending = StringComparer{Ascending: true, Field: Type}
+	// TypeDescending sort by type in descending order.
+	TypeDescending = StringComparer{Field: Type}
+	// GroupAscending sort by group name in ascending order.
+	GroupAscending = StringComparer{Ascending: true, Field: Group}
+	// GroupDescending sort by group name in descending order.
+	GroupDescending = StringComparer{Field: Group}
+	// DeprecatedLastThenLongName put the deprecated flags at the end and sort each section by long name in ascending order.
+	DeprecatedLastThenLongName = Chain(DeprecatedLast, LongNameAscending)
 )
@@ -0,0 +1,43 @@
+package by
+
+import "github.com/xitonix/flags/core"
+
+// ChainComparer represents an implementation of the by.Comparer interface which compares the flags using
+// a list of comparers in order.
+type ChainComparer struct {
+	comparers []Comparer
+}
+
+// Chain creates a new comparer to sort the flags by multiple keys.
+//
+// The flags will be compared using the first comparer. The next comparer will only be consulted if the flags are
+// considered equal by the previous ones. The nil comparers (i.e. by.DeclarationOrder) will be ignored. Since the
+// flags are sorted using a stable sort, the declaration order is always the final tiebreaker.
+//
+// Example:
+//
+// 	bucket := flags.NewBucket(config.WithSortOrder(by.Chain(by.RequiredFirst, by.LongNameAscending)))
+func Chain(comparers ...Comparer) ChainComparer {
+	c := ChainComparer{
+		comparers: make([]Comparer, 0, len(comparers)),
+	}
+	for _, comparer := range comparers {
+		if comparer != nil {
+			c.comparers = append(c.comparers, comparer)
+		}
+	}
+	return c
+}
+
+// LessThan returns true if f1 must be placed before f2 according to the first comparer which can tell the flags apart.
+//
+// Two flags are considered equal by a comparer, if neither or both of them are less than the other one.
+func (c ChainComparer) LessThan(f1, f2 core.Flag) bool {
+	for _, comparer := range c.comparers {
+		less, greater := comparer.LessThan(f1, f2), comparer.LessThan(f2, f1)
+		if less != greater {
+			return less
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package by_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags/by"
+	"github.com/xitonix/flags/core"
+)
+
+func TestChain(t *testing.T) {
+	testCases := []struct {
+		title    string
+		comparer by.Comparer
+		flags    []core.Flag
+		expected string
+	}{
+		{
+			title:    "no comparers",
+			comparer: by.Chain(),
+			flags: []core.Flag{
+				core.NewString("b", ""),
+				core.NewString("a", ""),
+			},
+			expected: "b,a",
+		},
+		{
+			title:    "declaration order comparer",
+			comparer: by.Chain(by.DeclarationOrder),
+			flags: []core.Flag{
+				core.NewString("b", ""),
+				core.NewString("a", ""),
+			},
+			expected: "b,a",
+		},
+		{
+			title:    "single comparer",
+			comparer: by.Chain(by.LongNameAscending),
+			flags: []core.Flag{
+				core.NewString("b", ""),
+				core.NewString("a", ""),
+			},
+			expected: "a,b",
+		},
+		{
+			title:    "required first then long name",
+			comparer: by.Chain(by.RequiredFirst, by.LongNameAscending),
+			flags: []core.Flag{
+				core.NewString("d", ""),
+				core.NewString("c", "").Required(),
+				core.NewString("b", ""),
+				core.NewString("a", "").Required(),
+			},
+			expected: "a,c,b,d",
+		},
+		{
+			title:    "type then long name descending",
+			comparer: by.Chain(by.TypeAscending, by.LongNameDescending),
+			flags: []core.Flag{
+				core.NewString("a", ""),
+				core.NewInt("b", ""),
+				core.NewString("c", ""),
+				core.NewInt("d", ""),
+			},
+			expected: "d,b,c,a",
+		},
+		{
+			title:    "group then declaration order",
+			comparer: by.Chain(by.GroupAscending),
+			flags: []core.Flag{
+				core.NewString("d", "").WithGroup("y"),
+				core.NewString("c", "").WithGroup("x"),
+				core.NewString("b", "").WithGroup("y"),
+				core.NewString("a", "").WithGroup("x"),
+			},
+			expected: "c,a,d,b",
+		},
+		{
+			title:    "hidden last",
+			comparer: by.Chain(by.HiddenLast),
+			flags: []core.Flag{
+				core.NewString("c", "").Hide(),
+				core.NewString("b", ""),
+				core.NewString("a", ""),
+			},
+			expected: "b,a,c",
+		},
+		{
+			title:    "deprecated last then long name",
+			comparer: by.DeprecatedLastThenLongName,
+			flags: []core.Flag{
+				core.NewString("d", ""),
+				core.NewString("c", "").MarkAsDeprecated(),
+				core.NewString("b", ""),
+				core.NewString("a", "").MarkAsDeprecated(),
+			},
+			expected: "b,d,a,c",
+		},
+		{
+			title:    "nested chains",
+			comparer: by.Chain(by.Chain(by.RequiredLast), by.Chain(by.DeprecatedFirst, by.LongNameAscending)),
+			flags: []core.Flag{
+				core.NewString("d", "").Required(),
+				core.NewString("c", ""),
+				core.NewString("b", "").MarkAsDeprecated(),
+				core.NewString("a", ""),
+			},
+			expected: "b,a,c,d",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			sort.SliceStable(tc.flags, func(i, j int) bool {
+				return tc.comparer.LessThan(tc.flags[i], tc.flags[j])
+			})
+			names := make([]string, len(tc.flags))
+			for i, f := range tc.flags {
+				names[i] = f.LongName()
+			}
+			actual := strings.Join(names, ",")
+			if actual != tc.expected {
+				t.Errorf("Expected order: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -19,6 +19,10 @@ const (
 	Key
 	// Usage usage field
 	Usage
+	// Type type field
+	Type
+	// Group group name field
+	Group
 )
 
 // StringComparer represents an implementation of the by.Comparer interface.
@@ -52,6 +56,10 @@ func (f StringComparer) getStringFieldsToCompare(f1, f2 core.Flag) (string, stri
 		return f1.ShortName(), f2.ShortName()
 	case Key:
 		return f1.Key().String(), f2.Key().String()
+	case Type:
+		return f1.Type(), f2.Type()
+	case Group:
+		return core.GroupOf(f1), core.GroupOf(f2)
 	default:
 		return f1.Usage(), f2.Usage()
 	}
@@ -232,6 +232,40 @@ func TestStringComparer_LessThan(t *testing.T) {
 			f2:               mocks.NewFlagWithUsage("a-long", "a", "a"),
 			expectedLessThan: true,
 		},
+		// Group
+		{
+			title:            "f1 group less than f2 group ascending",
+			field:            by.Group,
+			isAscending:      true,
+			f1:               mocks.NewFlag("x-long", "x").WithGroup("a"),
+			f2:               mocks.NewFlag("a-long", "a").WithGroup("x"),
+			expectedLessThan: true,
+		},
+		{
+			title:            "f1 group less than f2 group descending",
+			field:            by.Group,
+			isAscending:      false,
+			f1:               mocks.NewFlag("x-long", "x").WithGroup("a"),
+			f2:               mocks.NewFlag("a-long", "a").WithGroup("x"),
+			expectedLessThan: false,
+		},
+		{
+			title:            "ungrouped f1 ascending",
+			field:            by.Group,
+			isAscending:      true,
+			f1:               mocks.NewFlag("x-long", "x"),
+			f2:               mocks.NewFlag("a-long", "a").WithGroup("a"),
+			expectedLessThan: true,
+		},
+		// Type
+		{
+			title:            "equal types ascending",
+			field:            by.Type,
+			isAscending:      true,
+			f1:               mocks.NewFlag("a-long", "a"),
+			f2:               mocks.NewFlag("x-long", "x"),
+			expectedLessThan: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,376 @@
+package flags
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/internal"
+)
+
+// Shell represents a command line shell for which the completion script can be generated.
+type Shell string
+
+const (
+	// Bash the Bourne Again shell.
+	Bash Shell = "bash"
+	// Zsh the Z shell.
+	Zsh Shell = "zsh"
+	// Fish the friendly interactive shell.
+	Fish Shell = "fish"
+)
+
+// CompletionFunc returns the dynamic completion candidates for a flag value which starts with the specified prefix.
+type CompletionFunc func(prefix string) []string
+
+// completeCommand is the hidden command which is used by the completion scripts to query the dynamic candidates at runtime.
+//
+// For example 'tool __complete --region eu' will print all the candidates of the --region flag which start with 'eu'.
+const completeCommand = "__complete"
+
+type completionItem struct {
+	long, short string
+	usage       string
+	takesValue  bool
+	repeatable  bool
+	values      []string
+	hint        core.ValueHint
+	dynamic     bool
+}
+
+// Completion writes the completion script of the specified shell to w.
+//
+// The script will be generated from the registered flags. Hidden flags will not be included. The list of acceptable
+// values of each flag (See WithValidRange) will be offered as completion candidates, and the flags expecting a file
+// system path will be completed using the shell's file completion.
+//
+// The dynamic completion candidates registered by RegisterCompletion() are queried from the tool at runtime,
+// using the hidden __complete command.
+func (b *Bucket) Completion(shell Shell, w io.Writer) error {
+	items := b.completionItems()
+	switch shell {
+	case Bash:
+		return b.writeBashCompletion(w, items)
+	case Zsh:
+		return b.writeZshCompletion(w, items)
+	case Fish:
+		return b.writeFishCompletion(w, items)
+	default:
+		err := internal.NewMessageErr(internal.MsgUnsupportedShell, shell, Bash, Zsh, Fish)
+		err.Localise(b.messages().Message)
+		return err
+	}
+}
+
+// RegisterCompletion registers a callback function to provide the dynamic completion candidates for the flag with
+// the specified long name.
+//
+// The callback will be called at runtime by the shell completion scripts. The candidates provided by the callback
+// take priority over the valid range of the flag.
+func (b *Bucket) RegisterCompletion(longName string, callback CompletionFunc) {
+	if b.completions == nil {
+		b.completions = make(map[string]CompletionFunc)
+	}
+	b.completions[internal.SanitiseLongName(longName)] = callback
+}
+
+func (b *Bucket) printCompletions(words []string) error {
+	for _, candidate := range b.complete(words) {
+		if _, err := io.WriteString(b.opts.HelpWriter, candidate+"\n"); err != nil {
+			return err
+		}
+	}
+	return b.opts.HelpWriter.Close()
+}
+
+// complete returns the completion candidates of the last word.
+func (b *Bucket) complete(words []string) []string {
+	var cur, prev string
+	if n := len(words); n > 0 {
+		cur = words[n-1]
+		if n > 1 {
+			prev = words[n-2]
+		}
+	}
+
+	items := b.completionItems()
+	if strings.HasPrefix(cur, "-") {
+		if i := strings.Index(cur, "="); i > 0 {
+			item := findCompletionItem(items, cur[:i])
+			if item == nil || !item.takesValue {
+				return nil
+			}
+			values := b.valueCandidates(item, cur[i+1:])
+			for j := range values {
+				values[j] = cur[:i+1] + values[j]
+			}
+			return values
+		}
+		candidates := make([]string, 0)
+		for _, item := range items {
+			for _, name := range item.names() {
+				if strings.HasPrefix(name, cur) {
+					candidates = append(candidates, name)
+				}
+			}
+		}
+		return candidates
+	}
+
+	if item := findCompletionItem(items, prev); item != nil && item.takesValue {
+		return b.valueCandidates(item, cur)
+	}
+	return nil
+}
+
+func (b *Bucket) valueCandidates(item *completionItem, prefix string) []string {
+	if cb, ok := b.completions[item.long]; ok && cb != nil {
+		return cb(prefix)
+	}
+	candidates := make([]string, 0)
+	for _, value := range item.values {
+		if strings.HasPrefix(value, prefix) {
+			candidates = append(candidates, value)
+		}
+	}
+	return candidates
+}
+
+func (b *Bucket) completionItems() []*completionItem {
+	items := make([]*completionItem, 0)
+	for _, f := range b.sortFlags() {
+		if f.IsHidden() {
+			continue
+		}
+		item := &completionItem{
+			long:       f.LongName(),
+			short:      f.ShortName(),
+			usage:      f.Usage(),
+			takesValue: takesValue(f),
+		}
+		if _, ok := f.(core.Repeatable); ok {
+			item.repeatable = true
+		}
+		if p, ok := f.(core.ValidRangeProvider); ok {
+			item.values = p.ValidRange()
+		}
+		if p, ok := f.(core.ValueHintProvider); ok {
+			item.hint = p.ValueHint()
+		}
+		if cb, ok := b.completions[f.LongName()]; ok && cb != nil {
+			item.dynamic = true
+		}
+		items = append(items, item)
+	}
+	items = append(items, &completionItem{long: "help", short: "h", usage: b.messages().Message(core.MsgHelpUsage)})
+	if b.version != nil {
+		items = append(items, &completionItem{long: "version", short: "V", usage: b.messages().Message(core.MsgVersionUsage)})
+	}
+	return items
+}
+
+// takesValue returns true if the flag cannot be set by its presence alone.
+func takesValue(f core.Flag) bool {
+	if _, ok := f.(core.EmptyValueProvider); ok {
+		return false
+	}
+	if _, ok := f.(core.Repeatable); ok {
+		return false
+	}
+	return true
+}
+
+func findCompletionItem(items []*completionItem, name string) *completionItem {
+	if internal.IsEmpty(name) {
+		return nil
+	}
+	for _, item := range items {
+		for _, n := range item.names() {
+			if n == name {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+func (c *completionItem) names() []string {
+	names := []string{"--" + c.long}
+	if !internal.IsEmpty(c.short) {
+		names = append(names, "-"+c.short)
+	}
+	return names
+}
+
+func (b *Bucket) writeBashCompletion(w io.Writer, items []*completionItem) error {
+	prog := b.opts.ProgramName
+	fn := "_" + completionFunctionName(prog) + "_completions"
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "# bash completion for %s\n\n", prog)
+	fmt.Fprintf(sb, "%s()\n{\n", fn)
+	sb.WriteString("    local cur prev\n")
+	sb.WriteString("    cur=\"${COMP_WORDS[COMP_CWORD]}\"\n")
+	sb.WriteString("    prev=\"${COMP_WORDS[COMP_CWORD-1]}\"\n\n")
+	sb.WriteString("    case \"${prev}\" in\n")
+	names := make([]string, 0)
+	for _, item := range items {
+		names = append(names, item.names()...)
+		if !item.takesValue {
+			continue
+		}
+		var reply string
+		switch {
+		case item.dynamic:
+			reply = `$("${COMP_WORDS[0]}" ` + completeCommand + ` "${prev}" "${cur}" 2>/dev/null)`
+		case len(item.values) > 0:
+			reply = `$(compgen -W ` + posixQuote(strings.Join(item.values, " ")) + ` -- "${cur}")`
+		case item.hint == core.FileHint:
+			reply = `$(compgen -f -- "${cur}")`
+		case item.hint == core.DirectoryHint:
+			reply = `$(compgen -d -- "${cur}")`
+		default:
+			continue
+		}
+		fmt.Fprintf(sb, "        %s)\n", strings.Join(item.names(), "|"))
+		fmt.Fprintf(sb, "            COMPREPLY=( %s )\n", reply)
+		sb.WriteString("            return 0\n")
+		sb.WriteString("            ;;\n")
+	}
+	sb.WriteString("    esac\n\n")
+	sb.WriteString("    if [[ \"${cur}\" == -* ]]; then\n")
+	fmt.Fprintf(sb, "        COMPREPLY=( $(compgen -W %s -- \"${cur}\") )\n", posixQuote(strings.Join(names, " ")))
+	sb.WriteString("        return 0\n")
+	sb.WriteString("    fi\n")
+	sb.WriteString("}\n\n")
+	fmt.Fprintf(sb, "complete -o default -F %s %s\n", fn, prog)
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func (b *Bucket) writeZshCompletion(w io.Writer, items []*completionItem) error {
+	prog := b.opts.ProgramName
+	fn := "_" + completionFunctionName(prog)
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "#compdef %s\n\n", prog)
+	fmt.Fprintf(sb, "%s_dynamic()\n{\n", fn)
+	sb.WriteString("    local -a candidates\n")
+	fmt.Fprintf(sb, "    candidates=(\"${(@f)$(${words[1]} %s \"$1\" \"$PREFIX\" 2>/dev/null)}\")\n", completeCommand)
+	sb.WriteString("    compadd -a candidates\n")
+	sb.WriteString("}\n\n")
+	fmt.Fprintf(sb, "%s()\n{\n", fn)
+	sb.WriteString("    _arguments -s")
+	for _, item := range items {
+		sb.WriteString(" \\\n        ")
+		sb.WriteString(zshSpec(item, fn))
+	}
+	sb.WriteString("\n}\n\n")
+	fmt.Fprintf(sb, "if [ \"$funcstack[1]\" = \"%s\" ]; then\n", fn)
+	fmt.Fprintf(sb, "    %s \"$@\"\n", fn)
+	sb.WriteString("else\n")
+	fmt.Fprintf(sb, "    compdef %s %s\n", fn, prog)
+	sb.WriteString("fi\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func zshSpec(item *completionItem, fn string) string {
+	var spec string
+	names := item.names()
+	usage := "[" + zshEscape(item.usage, "[]") + "]"
+	switch {
+	case item.repeatable && len(names) > 1:
+		spec = "'*'{" + strings.Join(names, ",") + "}'" + usage
+	case item.repeatable:
+		spec = "'*" + names[0] + usage
+	case len(names) > 1:
+		spec = "'(" + strings.Join(names, " ") + ")'{" + strings.Join(names, ",") + "}'" + usage
+	default:
+		spec = "'" + names[0] + usage
+	}
+	if item.takesValue {
+		message := ":" + item.long + ":"
+		switch {
+		case item.dynamic:
+			message += "{" + fn + "_dynamic --" + item.long + "}"
+		case len(item.values) > 0:
+			values := make([]string, len(item.values))
+			for i, value := range item.values {
+				values[i] = zshEscape(value, " ():")
+			}
+			message += "(" + strings.Join(values, " ") + ")"
+		case item.hint == core.FileHint:
+			message += "_files"
+		case item.hint == core.DirectoryHint:
+			message += "_files -/"
+		}
+		spec += message
+	}
+	return spec + "'"
+}
+
+func (b *Bucket) writeFishCompletion(w io.Writer, items []*completionItem) error {
+	prog := b.opts.ProgramName
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "# fish completion for %s\n\n", prog)
+	for _, item := range items {
+		fmt.Fprintf(sb, "complete -c %s", prog)
+		if !internal.IsEmpty(item.short) {
+			fmt.Fprintf(sb, " -s %s", item.short)
+		}
+		fmt.Fprintf(sb, " -l %s", item.long)
+		if !internal.IsEmpty(item.usage) {
+			fmt.Fprintf(sb, " -d %s", fishQuote(item.usage))
+		}
+		if item.takesValue {
+			switch {
+			case item.dynamic:
+				fmt.Fprintf(sb, " -x -a %s", fishQuote("("+prog+" "+completeCommand+" --"+item.long+" (commandline -ct))"))
+			case len(item.values) > 0:
+				fmt.Fprintf(sb, " -x -a %s", fishQuote(strings.Join(item.values, " ")))
+			case item.hint == core.FileHint:
+				sb.WriteString(" -r -F")
+			case item.hint == core.DirectoryHint:
+				sb.WriteString(" -x -a '(__fish_complete_directories (commandline -ct))'")
+			default:
+				sb.WriteString(" -r")
+			}
+		}
+		sb.WriteString("\n")
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// completionFunctionName converts the program name to a valid shell function name.
+func completionFunctionName(prog string) string {
+	invalid := regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	return invalid.ReplaceAllString(prog, "_")
+}
+
+func posixQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+func fishQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	return "'" + strings.Replace(s, "'", `\'`, -1) + "'"
+}
+
+// zshEscape escapes the specified special characters (plus single quotes and backslashes) of a zsh _arguments spec.
+func zshEscape(s, special string) string {
+	sb := &strings.Builder{}
+	for _, r := range s {
+		switch {
+		case r == '\'':
+			sb.WriteString(`'\''`)
+		case r == '\\' || strings.ContainsRune(special, r):
+			sb.WriteRune('\\')
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,289 @@
+package flags
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags/config"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+	"github.com/xitonix/flags/test"
+)
+
+func newCompletionTestBucket(args []string, w *mocks.InMemoryWriter, tm *mocks.Terminator) *Bucket {
+	bucket := newBucket(args, mocks.NewEnvReader(),
+		config.WithProgramName("my-tool"),
+		config.WithHelpWriter(w),
+		config.WithLogger(&mocks.Logger{}),
+		config.WithTerminator(tm))
+	bucket.Int("port", "Port 'number'").WithShort("p").WithValidRange(80, 443)
+	bucket.String("region", "Region")
+	bucket.Bool("debug", "Debug mode").WithShort("d")
+	bucket.Verbosity("Verbosity")
+	bucket.String("secret", "Secret").Hide()
+	bucket.StringSlice("names", "Names")
+	bucket.RegisterCompletion("region", func(prefix string) []string {
+		return []string{prefix + "-1", prefix + "-2"}
+	})
+	return bucket
+}
+
+func TestBucket_Completion(t *testing.T) {
+	testCases := []struct {
+		title            string
+		shell            Shell
+		expectedErr      string
+		expectedLines    []string
+		notExpectedLines []string
+	}{
+		{
+			title:       "unsupported shell",
+			shell:       "powershell",
+			expectedErr: "powershell is not a supported shell",
+		},
+		{
+			title: "bash",
+			shell: Bash,
+			expectedLines: []string{
+				"_my_tool_completions()",
+				"--port|-p)",
+				"COMPREPLY=( $(compgen -W '80 443' -- \"${cur}\") )",
+				"--region)",
+				"COMPREPLY=( $(\"${COMP_WORDS[0]}\" __complete \"${prev}\" \"${cur}\" 2>/dev/null) )",
+				"'--port -p --region --debug -d --verbose -v --names --help -h'",
+				"complete -o default -F _my_tool_completions my-tool",
+			},
+			notExpectedLines: []string{"secret", "--debug|-d)", "--version"},
+		},
+		{
+			title: "zsh",
+			shell: Zsh,
+			expectedLines: []string{
+				"#compdef my-tool",
+				`'(--port -p)'{--port,-p}'[Port '\''number'\'']:port:(80 443)'`,
+				`'--region[Region]:region:{_my_tool_dynamic --region}'`,
+				`'(--debug -d)'{--debug,-d}'[Debug mode]'`,
+				`'*'{--verbose,-v}'[Verbosity]'`,
+				`'--names[Names]:names:'`,
+				"compdef _my_tool my-tool",
+			},
+			notExpectedLines: []string{"secret", "--version"},
+		},
+		{
+			title: "fish",
+			shell: Fish,
+			expectedLines: []string{
+				`complete -c my-tool -s p -l port -d 'Port \'number\'' -x -a '80 443'`,
+				`complete -c my-tool -l region -d 'Region' -x -a '(my-tool __complete --region (commandline -ct))'`,
+				`complete -c my-tool -s d -l debug -d 'Debug mode'`,
+				`complete -c my-tool -s v -l verbose -d 'Verbosity'`,
+				`complete -c my-tool -l names -d 'Names' -r`,
+				`complete -c my-tool -s h -l help -d 'Show help'`,
+			},
+			notExpectedLines: []string{"secret", "--version"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			bucket := newCompletionTestBucket(nil, mocks.NewInMemoryWriter(), &mocks.Terminator{})
+			buf := &bytes.Buffer{}
+			err := bucket.Completion(tc.shell, buf)
+			if !test.ErrorContains(err, tc.expectedErr) {
+				t.Errorf("Expected '%v', but received %v", tc.expectedErr, err)
+			}
+			actual := buf.String()
+			for _, line := range tc.expectedLines {
+				if !strings.Contains(actual, line) {
+					t.Errorf("Expected the script to contain %s, Actual:\n%s", line, actual)
+				}
+			}
+			for _, line := range tc.notExpectedLines {
+				if strings.Contains(actual, line) {
+					t.Errorf("Did not expect the script to contain %s, Actual:\n%s", line, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestBucket_Completion_With_Version(t *testing.T) {
+	bucket := newCompletionTestBucket(nil, mocks.NewInMemoryWriter(), &mocks.Terminator{})
+	bucket.Version("1.0.0")
+	buf := &bytes.Buffer{}
+	if err := bucket.Completion(Fish, buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	expected := "complete -c my-tool -s V -l version -d 'Print version information'"
+	if !strings.Contains(buf.String(), expected) {
+		t.Errorf("Expected the script to contain %s, Actual:\n%s", expected, buf.String())
+	}
+}
+
+func TestBucket_Completion_Localisation(t *testing.T) {
+	bucket := newBucket(nil, mocks.NewEnvReader(),
+		config.WithProgramName("my-tool"),
+		config.WithMessageCatalogue(core.MessageMap{
+			core.MsgHelpUsage:        "Hilfe anzeigen",
+			core.MsgVersionUsage:     "Versionsinformationen ausgeben",
+			core.MsgUnsupportedShell: "%s wird nicht unterstützt. Unterstützte Shells: %s, %s und %s",
+		}))
+	bucket.Version("1.0.0")
+	expectedErr := "powershell wird nicht unterstützt. Unterstützte Shells: bash, zsh und fish"
+	if err := bucket.Completion("powershell", &bytes.Buffer{}); !test.ErrorContainsExact(err, expectedErr) {
+		t.Errorf("Expected '%s', but received %v", expectedErr, err)
+	}
+	buf := &bytes.Buffer{}
+	if err := bucket.Completion(Fish, buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	expectedLines := []string{
+		"complete -c my-tool -s h -l help -d 'Hilfe anzeigen'",
+		"complete -c my-tool -s V -l version -d 'Versionsinformationen ausgeben'",
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("Expected the script to contain %s, Actual:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestBucket_Completion_Path_Hints(t *testing.T) {
+	testCases := []struct {
+		title         string
+		shell         Shell
+		expectedLines []string
+	}{
+		{
+			title: "bash",
+			shell: Bash,
+			expectedLines: []string{
+				"--config|-c)",
+				"COMPREPLY=( $(compgen -f -- \"${cur}\") )",
+				"--output)",
+				"COMPREPLY=( $(compgen -d -- \"${cur}\") )",
+			},
+		},
+		{
+			title: "zsh",
+			shell: Zsh,
+			expectedLines: []string{
+				`'(--config -c)'{--config,-c}'[Config file]:config:_files'`,
+				`'--output[Output directory]:output:_files -/'`,
+			},
+		},
+		{
+			title: "fish",
+			shell: Fish,
+			expectedLines: []string{
+				`complete -c my-tool -s c -l config -d 'Config file' -r -F`,
+				`complete -c my-tool -l output -d 'Output directory' -x -a '(__fish_complete_directories (commandline -ct))'`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			bucket := newCompletionTestBucket(nil, mocks.NewInMemoryWriter(), &mocks.Terminator{})
+			bucket.FilePath("config", "Config file").WithShort("c")
+			bucket.DirPath("output", "Output directory")
+			buf := &bytes.Buffer{}
+			if err := bucket.Completion(tc.shell, buf); err != nil {
+				t.Fatalf("Expected no error, but received %v", err)
+			}
+			actual := buf.String()
+			for _, line := range tc.expectedLines {
+				if !strings.Contains(actual, line) {
+					t.Errorf("Expected the script to contain %s, Actual:\n%s", line, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestBucket_Parse_Completion_Request(t *testing.T) {
+	testCases := []struct {
+		title    string
+		args     []string
+		expected []string
+	}{
+		{
+			title:    "no words",
+			args:     []string{"__complete"},
+			expected: []string{},
+		},
+		{
+			title:    "all flags",
+			args:     []string{"__complete", "-"},
+			expected: []string{"--port\n", "-p\n", "--region\n", "--debug\n", "-d\n", "--verbose\n", "-v\n", "--names\n", "--help\n", "-h\n"},
+		},
+		{
+			title:    "long names with prefix",
+			args:     []string{"__complete", "--p"},
+			expected: []string{"--port\n"},
+		},
+		{
+			title:    "hidden flags are excluded",
+			args:     []string{"__complete", "--se"},
+			expected: []string{},
+		},
+		{
+			title:    "valid range",
+			args:     []string{"__complete", "--port", ""},
+			expected: []string{"80\n", "443\n"},
+		},
+		{
+			title:    "valid range with prefix",
+			args:     []string{"__complete", "-p", "4"},
+			expected: []string{"443\n"},
+		},
+		{
+			title:    "valid range with equal sign",
+			args:     []string{"__complete", "--port=8"},
+			expected: []string{"--port=80\n"},
+		},
+		{
+			title:    "dynamic candidates",
+			args:     []string{"__complete", "--region", "eu"},
+			expected: []string{"eu-1\n", "eu-2\n"},
+		},
+		{
+			title:    "flags without values",
+			args:     []string{"__complete", "--debug", ""},
+			expected: []string{},
+		},
+		{
+			title:    "free form values",
+			args:     []string{"__complete", "--names", ""},
+			expected: []string{},
+		},
+		{
+			title:    "unknown flag",
+			args:     []string{"__complete", "--unknown", ""},
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			w := mocks.NewInMemoryWriter()
+			tm := &mocks.Terminator{}
+			bucket := newCompletionTestBucket(tc.args, w, tm)
+			bucket.Parse()
+
+			if !tm.IsTerminated {
+				t.Errorf("Expected to terminate, but it did not happen")
+			}
+
+			if tm.Code != core.SuccessExitCode {
+				t.Errorf("Expected termination code: %d, Actual: %d", core.SuccessExitCode, tm.Code)
+			}
+
+			if !reflect.DeepEqual(w.Lines, tc.expected) {
+				t.Errorf("Expected candidates: %q, Actual: %q", tc.expected, w.Lines)
+			}
+		})
+	}
+}
@@ -7,4 +7,10 @@ const (
 	DeprecatedFlagIndicatorDefault = "[DEPRECATED]"
 	// RequiredFlagMarkDefault default required flag indicator
 	RequiredFlagMarkDefault = "*"
+	// KeyFormatStringDefault default key format string
+	KeyFormatStringDefault = "[$%s]"
+	// DefaultGroupTitleDefault default heading of the ungrouped flags section
+	DefaultGroupTitleDefault = "General"
+	// VersionTemplateDefault default version output template
+	VersionTemplateDefault = "{{.Program}} version {{.Version}}{{with .Commit}} ({{.}}{{if $.Dirty}}, modified{{end}}){{end}}\n"
 )
@@ -2,12 +2,74 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strings"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/internal"
 )
 
 // Logger represent the default logger which writes to standard output.
-type Logger struct{}
+//
+// The logger implements core.LeveledLogger. The minimum level of the zero value is core.LevelInfo, which means the
+// debug events will not be printed, unless the level has been lowered using SetLevel (i.e. by --log-level=debug).
+type Logger struct {
+	theme *core.Theme
+	level core.Level
+}
+
+// SetTheme sets the theme of the logger. A nil theme disables styling.
+//
+// The theme will be ignored if the standard output is not a terminal.
+func (l *Logger) SetTheme(theme *core.Theme) {
+	if !internal.IsTerminal(os.Stdout) {
+		theme = nil
+	}
+	l.theme = theme
+}
+
+// SetLevel sets the minimum level of the events which must be printed.
+func (l *Logger) SetLevel(level core.Level) {
+	l.level = level
+}
+
+// Enabled returns true if the level is greater than or equal to the minimum level of the logger.
+func (l *Logger) Enabled(level core.Level) bool {
+	return level >= l.level
+}
+
+// Log prints the event to standard output, prefixed by its level (i.e. DEBUG parsing flags count=2).
+func (l *Logger) Log(level core.Level, msg string, attrs ...core.Attr) {
+	if !l.Enabled(level) {
+		return
+	}
+	msg = strings.ToUpper(level.String()) + " " + core.FormatEvent(msg, attrs...)
+	if l.theme != nil {
+		switch {
+		case level >= core.LevelError:
+			msg = l.theme.Error.Apply(msg)
+		case level == core.LevelWarn:
+			msg = l.theme.Warning.Apply(msg)
+		}
+	}
+	fmt.Println(msg)
+}
 
 // Print prints the error to standard output.
-func (*Logger) Print(err error) {
-	fmt.Println(err.Error())
+//
+// The events of a core.LoggerAdapter will be printed at their own level (See Log).
+func (l *Logger) Print(err error) {
+	if e, ok := err.(*core.Event); ok {
+		l.Log(e.Level, e.Msg, e.Attrs...)
+		return
+	}
+	msg := err.Error()
+	if l.theme != nil {
+		style := l.theme.Error
+		if _, ok := err.(*core.DeprecationWarning); ok {
+			style = l.theme.Warning
+		}
+		msg = style.Apply(msg)
+	}
+	fmt.Println(msg)
 }
@@ -3,6 +3,8 @@ package config
 import (
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/xitonix/flags/by"
 	"github.com/xitonix/flags/core"
@@ -11,6 +13,18 @@ import (
 
 // Options holds the configuration settings of a bucket
 type Options struct {
+	// ProgramName is the name of the running tool (default: the base name of os.Args[0]).
+	ProgramName string
+	// Synopsis is the usage line of the running tool (i.e. "tool [flags] <file>").
+	//
+	// If not specified, "ProgramName [flags]" will be used.
+	Synopsis string
+	// Description is a paragraph which describes what the running tool does.
+	Description string
+	// Examples is the list of the usage examples which will be printed in the help output.
+	Examples []core.Example
+	// Footer is the epilogue which will be printed at the end of the help output.
+	Footer string
 	// KeyPrefix the prefix for the flag keys (default: "").
 	KeyPrefix string
 	// AutoKey enables automatic key generation for the bucket (default: false)
@@ -31,6 +45,16 @@ type Options struct {
 	// It is responsible for formatting the help output.
 	// The default formatter generates tabbed output.
 	HelpFormatter core.HelpFormatter
+	// Theme is the theme of the help and error output (default: core.DefaultTheme()).
+	//
+	// Styling will be disabled if the NO_COLOR environment variable is set, or the output is not a terminal.
+	// A nil theme disables styling altogether.
+	Theme *core.Theme
+	// Messages is the catalogue which is used to translate the error messages and the help output (default: core.EnglishCatalogue).
+	//
+	// The English default deprecation mark, default value format string and the default group title will also
+	// be replaced by their translations.
+	Messages core.MessageCatalogue
 	// HelpWriter is the bucket's help writer.
 	//
 	// The help writer is responsible to print the formatted help.
@@ -43,6 +67,19 @@ type Options struct {
 	// You can use the built-in sort orders such as by.KeyAscending, by.LongNameDescending, etc to override the defaults.
 	// Alternatively you can implement `by.Comparer` interface and use your own comparer to sort the help output.
 	Comparer by.Comparer
+	// GroupOrder is the order in which the flag groups will be printed in the help output.
+	//
+	// The groups which are not listed will be printed after the listed ones, in the same order as they have been declared.
+	// The ungrouped flags section will be printed first, unless DefaultGroupTitle has been listed.
+	GroupOrder []string
+	// GroupComparers holds the sort order of each flag group.
+	//
+	// The groups without a dedicated comparer will be sorted using the bucket's Comparer.
+	GroupComparers map[string]by.Comparer
+	// DefaultGroupTitle is the heading of the ungrouped flags section (default: config.DefaultGroupTitleDefault).
+	//
+	// The flags will only be printed under group headings, if at least one visible flag has been assigned to a group.
+	DefaultGroupTitle string
 	// DeprecationMark is the deprecation mark for the flags within the bucket (default: config.DeprecatedFlagIndicatorDefault).
 	//
 	// The deprecation mark is used in the help output to draw the users' attention.
@@ -53,28 +90,51 @@ type Options struct {
 	DefaultValueFormatString string
 	// RequiredFlagMark is used to mark a required flag in the help output.
 	RequiredFlagMark string
+	// KeyFormatString is the format string of the flag keys in the help output (default: config.KeyFormatStringDefault).
+	//
+	// The keys will be printed as they are, if the format string is empty.
+	KeyFormatString string
+	// EnvPresenceMark is printed next to the keys which have a value in the environment variables (default: "").
+	//
+	// The value itself will never be printed. The presence of the values will not be checked if the mark is empty.
+	EnvPresenceMark string
 	// PreSetCallback is a callback which will be called before the flag value has been set by a source.
 	PreSetCallback core.Callback
 	// PostSetCallback is a callback which will be called after the flag value has been set by a source.
 	PostSetCallback core.Callback
+	// Validators are the cross flag validators which will be called once all the flag values have been resolved.
+	//
+	// The validators will be called in order, after the required flags have been checked.
+	Validators []core.CrossFlagValidator
+	// VersionTemplate is the text/template which is used to print the version information (default: config.VersionTemplateDefault).
+	//
+	// The template will only be used if the built-in version flag has been enabled on the bucket.
+	VersionTemplate string
 }
 
 // NewOptions creates a new Options object with default values.
 func NewOptions() *Options {
 	return &Options{
 		// SetID default values here
+		ProgramName:              filepath.Base(os.Args[0]),
 		KeyPrefix:                "",
 		AutoKeys:                 false,
 		Comparer:                 by.DeclarationOrder,
+		GroupComparers:           make(map[string]by.Comparer),
+		DefaultGroupTitle:        DefaultGroupTitleDefault,
 		Terminator:               &Terminator{},
 		Logger:                   &Logger{},
 		HelpFormatter:            &core.TabbedHelpFormatter{},
 		HelpWriter:               core.NewTabbedHelpWriter(os.Stdout),
+		Theme:                    core.DefaultTheme(),
+		Messages:                 core.EnglishCatalogue{},
 		DeprecationMark:          DeprecatedFlagIndicatorDefault,
 		DefaultValueFormatString: DefaultValueFormatStringDefault,
 		RequiredFlagMark:         RequiredFlagMarkDefault,
+		KeyFormatString:          KeyFormatStringDefault,
 		PreSetCallback:           nil,
 		PostSetCallback:          nil,
+		VersionTemplate:          VersionTemplateDefault,
 	}
 }
 
@@ -97,6 +157,27 @@ func WithPostSetCallback(callback core.Callback) Option {
 	}
 }
 
+// WithValidator adds a cross flag validator to the bucket.
+//
+// The validator will be called once all the flag values have been resolved and the required flags have been checked.
+// The parsing will fail if the validator returns an error. The option can be used multiple times to add more validators.
+//
+// Example:
+//
+//	config.WithValidator(func(set core.FlagSet) error {
+//		if set.Lookup("tls-cert").IsSet() && !set.Lookup("tls-key").IsSet() {
+//			return errors.New("--tls-key is required when --tls-cert is provided")
+//		}
+//		return nil
+//	})
+func WithValidator(validator core.CrossFlagValidator) Option {
+	return func(options *Options) {
+		if validator != nil {
+			options.Validators = append(options.Validators, validator)
+		}
+	}
+}
+
 // WithSortOrder sets the sort order of the bucket.
 //
 // The comparer decides the order in which the flags will be displayed in the help output.
@@ -137,6 +218,26 @@ func WithDefaultValueFormatString(defaultValueFormatString string) Option {
 	}
 }
 
+// WithKeyFormatString sets the format string of the flag keys in the help output (i.e. "[$%s]" or "env: %s").
+//
+// The keys will be printed as they are, if the format string is empty.
+func WithKeyFormatString(format string) Option {
+	return func(options *Options) {
+		options.KeyFormatString = format
+	}
+}
+
+// WithEnvPresenceMark sets the mark which will be printed next to the keys which have a value in the environment
+// variables (i.e. "(set)").
+//
+// This can be used to show the users which flags have been configured through the environment, without printing
+// the values, which may contain secrets.
+func WithEnvPresenceMark(mark string) Option {
+	return func(options *Options) {
+		options.EnvPresenceMark = mark
+	}
+}
+
 // WithHelpFormatter sets the help formatter of the bucket.
 //
 // The help formatter is responsible for formatting the help output.
@@ -147,6 +248,28 @@ func WithHelpFormatter(hf core.HelpFormatter) Option {
 	}
 }
 
+// WithTheme sets the theme of the help and error output.
+//
+// Styling will be disabled if the NO_COLOR environment variable is set, or the output is not a terminal.
+// Passing nil disables styling altogether.
+func WithTheme(theme *core.Theme) Option {
+	return func(options *Options) {
+		options.Theme = theme
+	}
+}
+
+// WithMessageCatalogue sets the catalogue which will be used to translate the error messages and the help output.
+//
+// Passing nil resets the catalogue to English. See core.MessageMap for a map based catalogue.
+func WithMessageCatalogue(catalogue core.MessageCatalogue) Option {
+	return func(options *Options) {
+		if catalogue == nil {
+			catalogue = core.EnglishCatalogue{}
+		}
+		options.Messages = catalogue
+	}
+}
+
 // WithHelpWriter sets the help writer of the bucket.
 //
 // The help writer is responsible for printing the formatted help output.
@@ -195,3 +318,89 @@ func WithKeyPrefix(prefix string) Option {
 		options.KeyPrefix = internal.SanitiseFlagID(prefix)
 	}
 }
+
+// WithProgramName sets the name of the running tool.
+//
+// By default the base name of os.Args[0] will be used.
+func WithProgramName(name string) Option {
+	return func(options *Options) {
+		options.ProgramName = name
+	}
+}
+
+// WithVersionTemplate sets the text/template which is used to print the version information.
+//
+// The template will only be used if the built-in version flag has been enabled on the bucket.
+// The available fields are .Program, .Version, .Commit, .Time, .Dirty and .GoVersion.
+func WithVersionTemplate(template string) Option {
+	return func(options *Options) {
+		options.VersionTemplate = template
+	}
+}
+
+// WithSynopsis sets the usage line of the running tool (i.e. "tool [flags] <file>").
+//
+// If not specified, "ProgramName [flags]" will be used.
+func WithSynopsis(synopsis string) Option {
+	return func(options *Options) {
+		options.Synopsis = synopsis
+	}
+}
+
+// WithDescription sets the paragraph which describes what the running tool does.
+func WithDescription(description string) Option {
+	return func(options *Options) {
+		options.Description = description
+	}
+}
+
+// WithExample adds a usage example to the help output.
+//
+// The examples will be printed in the same order as they have been added.
+func WithExample(command, description string) Option {
+	return func(options *Options) {
+		options.Examples = append(options.Examples, core.Example{
+			Command:     command,
+			Description: description,
+		})
+	}
+}
+
+// WithFooter sets the epilogue which will be printed at the end of the help output.
+func WithFooter(footer string) Option {
+	return func(options *Options) {
+		options.Footer = footer
+	}
+}
+
+// WithGroupOrder sets the order in which the flag groups will be printed in the help output.
+//
+// The groups which are not listed will be printed after the listed ones, in the same order as they have been declared.
+// The ungrouped flags section will be printed first, unless the default group title has been listed.
+func WithGroupOrder(groups ...string) Option {
+	return func(options *Options) {
+		options.GroupOrder = groups
+	}
+}
+
+// WithGroupSortOrder sets the sort order of the flags within the specified group.
+//
+// The groups without a dedicated comparer will be sorted using the bucket's sort order (See WithSortOrder).
+// Use the default group title to set the sort order of the ungrouped flags.
+func WithGroupSortOrder(group string, c by.Comparer) Option {
+	return func(options *Options) {
+		if options.GroupComparers == nil {
+			options.GroupComparers = make(map[string]by.Comparer)
+		}
+		options.GroupComparers[strings.TrimSpace(group)] = c
+	}
+}
+
+// WithDefaultGroupTitle sets the heading of the ungrouped flags section in the help output.
+//
+// The flags will only be printed under group headings, if at least one visible flag has been assigned to a group.
+func WithDefaultGroupTitle(title string) Option {
+	return func(options *Options) {
+		options.DefaultGroupTitle = title
+	}
+}
@@ -19,10 +19,14 @@ type BoolFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	validate            func(in bool) error
+	validators          []Validator
 }
 
 // NewBool creates a new boolean flag.
@@ -53,7 +57,7 @@ func (f *BoolFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *BoolFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -146,21 +150,67 @@ func (f *BoolFlag) Hide() *BoolFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *BoolFlag) WithGroup(name string) *BoolFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *BoolFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *BoolFlag) WithDescription(description string) *BoolFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *BoolFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *BoolFlag) WithExamples(examples ...string) *BoolFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *BoolFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *BoolFlag) MarkAsDeprecated() *BoolFlag {
-	f.isDeprecated = true
+func (f *BoolFlag) MarkAsDeprecated(options ...DeprecationOption) *BoolFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *BoolFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
 //
 // The set operation will fail if the callback returns an error.
@@ -169,6 +219,20 @@ func (f *BoolFlag) WithValidationCallback(validate func(in bool) error) *BoolFla
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *BoolFlag) WithValidators(validators ...Validator) *BoolFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the descriptions of the validators of the flag.
+func (f *BoolFlag) Constraints() []Constraint {
+	return describeValidators(f.validators)
+}
+
 // Set sets the flag value.
 //
 // The value of a boolean flag can be explicitly set using true, false, 1 and 0 (i.e. --enabled true OR --enabled=1).
@@ -183,6 +247,17 @@ func (f *BoolFlag) Set(value string) error {
 		return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 	}
 
+	if err := f.validateValue(v); err != nil {
+		return err
+	}
+
+	f.set(v)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+func (f *BoolFlag) validateValue(v bool) error {
 	if f.validate != nil {
 		err := f.validate(v)
 		if err != nil {
@@ -190,8 +265,10 @@ func (f *BoolFlag) Set(value string) error {
 		}
 	}
 
-	f.set(v)
-	f.isSet = true
+	if err := applyValidators(v, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -218,6 +295,14 @@ func (f *BoolFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *BoolFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue)
+}
+
 // EmptyValue returns the value which will automatically be assigned to the flag if none of the sources has
 // provided a none-empty value.
 //
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -21,11 +22,16 @@ type BoolSliceFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	delimiter           string
 	validate            func(in bool) error
+	items               itemRules
+	validators          []Validator
 }
 
 // NewBoolSlice creates a new boolean slice flag.
@@ -57,7 +63,7 @@ func (f *BoolSliceFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *BoolSliceFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // WithShort sets the short name of the flag.
@@ -137,21 +143,67 @@ func (f *BoolSliceFlag) Hide() *BoolSliceFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *BoolSliceFlag) WithGroup(name string) *BoolSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *BoolSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *BoolSliceFlag) WithDescription(description string) *BoolSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *BoolSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *BoolSliceFlag) WithExamples(examples ...string) *BoolSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *BoolSliceFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *BoolSliceFlag) MarkAsDeprecated() *BoolSliceFlag {
-	f.isDeprecated = true
+func (f *BoolSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *BoolSliceFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *BoolSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // IsRequired returns true if the flag value must be provided.
 func (f *BoolSliceFlag) IsRequired() bool {
 	return f.isRequired
@@ -184,6 +236,39 @@ func (f *BoolSliceFlag) WithValidationCallback(validate func(in bool) error) *Bo
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *BoolSliceFlag) WithValidators(validators ...Validator) *BoolSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *BoolSliceFlag) WithMinItems(min int) *BoolSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *BoolSliceFlag) WithMaxItems(max int) *BoolSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *BoolSliceFlag) Unique() *BoolSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *BoolSliceFlag) Constraints() []Constraint {
+	return append(f.items.constraints(), describeValidators(f.validators)...)
+}
+
 // Set sets the flag value.
 //
 // The value of a boolean slice flag can be set using a comma (or any custom delimiter) separated string of true, false, 0 or 1.
@@ -204,20 +289,45 @@ func (f *BoolSliceFlag) Set(value string) error {
 			return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 		}
 
-		if f.validate != nil {
-			err := f.validate(item)
-			if err != nil {
-				return err
-			}
+		if err := f.validateItem(item); err != nil {
+			return err
 		}
+
 		list = append(list, item)
 	}
 
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
 	f.set(list)
 	f.isSet = true
 	return nil
 }
 
+// validateItem checks the item against the validation rules of the flag.
+func (f *BoolSliceFlag) validateItem(item bool) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *BoolSliceFlag) validateItems(list []bool) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return fmt.Sprint(list[i])
+	})
+}
+
 // ResetToDefault resets the value of this flag to default if a default value is specified.
 //
 // Calling this method on a flag without a default value will have no effect.
@@ -240,6 +350,19 @@ func (f *BoolSliceFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *BoolSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// The SI (decimal) and the IEC (binary) units of the byte sizes.
+const (
+	// KB 1000 bytes.
+	KB = 1000
+	// MB 1000 KB.
+	MB = 1000 * KB
+	// GB 1000 MB.
+	GB = 1000 * MB
+	// TB 1000 GB.
+	TB = 1000 * GB
+	// PB 1000 TB.
+	PB = 1000 * TB
+	// EB 1000 PB.
+	EB = 1000 * PB
+
+	// KiB 1024 bytes.
+	KiB = 1 << 10
+	// MiB 1024 KiB.
+	MiB = 1 << 20
+	// GiB 1024 MiB.
+	GiB = 1 << 30
+	// TiB 1024 GiB.
+	TiB = 1 << 40
+	// PiB 1024 TiB.
+	PiB = 1 << 50
+	// EiB 1024 PiB.
+	EiB = 1 << 60
+)
+
+var (
+	byteSizePattern = regexp.MustCompile(`^(\d+(?:\.\d+)?|\.\d+)\s*([a-zA-Z]*)$`)
+
+	byteSizeUnits = map[string]uint64{
+		"":    1,
+		"b":   1,
+		"kb":  KB,
+		"mb":  MB,
+		"gb":  GB,
+		"tb":  TB,
+		"pb":  PB,
+		"eb":  EB,
+		"kib": KiB,
+		"mib": MiB,
+		"gib": GiB,
+		"tib": TiB,
+		"pib": PiB,
+		"eib": EiB,
+	}
+
+	// byteSizeSymbols the units in descending order, used to format the sizes.
+	byteSizeSymbols = []struct {
+		symbol string
+		size   uint64
+	}{
+		{"EiB", EiB}, {"EB", EB},
+		{"PiB", PiB}, {"PB", PB},
+		{"TiB", TiB}, {"TB", TB},
+		{"GiB", GiB}, {"GB", GB},
+		{"MiB", MiB}, {"MB", MB},
+		{"KiB", KiB}, {"KB", KB},
+	}
+
+	errInvalidByteSize = errors.New("invalid byte size")
+)
+
+// ByteSize represents a number of bytes.
+type ByteSize uint64
+
+// ParseByteSize parses a human-readable byte size (i.e. 10MB or 1.5GiB).
+//
+// The size is a non-negative decimal number with an optional fraction, followed by an optional SI (KB, MB, GB, TB, PB, EB)
+// or IEC (KiB, MiB, GiB, TiB, PiB, EiB) unit. The units are case insensitive and the numbers without a unit are
+// treated as bytes. The fractions of a byte will be discarded (i.e. 1.5B is 1 byte).
+func ParseByteSize(value string) (ByteSize, error) {
+	matches := byteSizePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if matches == nil {
+		return 0, errInvalidByteSize
+	}
+	unit, ok := byteSizeUnits[strings.ToLower(matches[2])]
+	if !ok {
+		return 0, errInvalidByteSize
+	}
+	number, ok := new(big.Rat).SetString(matches[1])
+	if !ok {
+		return 0, errInvalidByteSize
+	}
+	number.Mul(number, new(big.Rat).SetInt(new(big.Int).SetUint64(unit)))
+	size := new(big.Int).Quo(number.Num(), number.Denom())
+	if !size.IsUint64() {
+		return 0, errInvalidByteSize
+	}
+	return ByteSize(size.Uint64()), nil
+}
+
+// String returns the human-readable representation of the size (i.e. 10MB or 1.5GiB).
+//
+// The largest unit which can represent the size with up to two decimal places will be used.
+// The sizes which cannot be represented by any unit will be printed in bytes (i.e. 1234B).
+func (s ByteSize) String() string {
+	size := new(big.Int).SetUint64(uint64(s))
+	for _, u := range byteSizeSymbols {
+		if uint64(s) < u.size {
+			continue
+		}
+		unit := new(big.Int).SetUint64(u.size)
+		whole, rem := new(big.Int).QuoRem(size, unit, new(big.Int))
+		cents, frac := new(big.Int).QuoRem(rem.Mul(rem, big.NewInt(100)), unit, new(big.Int))
+		if frac.Sign() != 0 {
+			continue
+		}
+		str := whole.String()
+		if cents.Sign() != 0 {
+			str += "." + strings.TrimRight(fmt.Sprintf("%02d", cents.Int64()), "0")
+		}
+		return str + u.symbol
+	}
+	return size.String() + "B"
+}
+
+func formatByteSize(v uint64) string {
+	return ByteSize(v).String()
+}
@@ -0,0 +1,310 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+)
+
+func TestByteSize(t *testing.T) {
+	testCases := []struct {
+		title         string
+		long          string
+		expectedLong  string
+		usage         string
+		expectedUsage string
+	}{
+		{
+			title:         "lowercase long name with usage",
+			long:          "long",
+			expectedLong:  "long",
+			usage:         "usage",
+			expectedUsage: "usage",
+		},
+		{
+			title:         "uppercase long name with usage",
+			long:          "LONG",
+			expectedLong:  "long",
+			usage:         " I must Stay Unchanged   ",
+			expectedUsage: " I must Stay Unchanged   ",
+		},
+		{
+			title:         "long name with white space",
+			long:          "   long  ",
+			expectedLong:  "long",
+			usage:         "     ",
+			expectedUsage: "     ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSize(tc.long, tc.usage)
+			checkFlagInitialState(t, f, "bytesize", tc.expectedUsage, tc.expectedLong, "")
+			checkFlagValues(t, uint64(0), f.Get(), f.Var())
+		})
+	}
+}
+
+func TestByteSizeFlag_WithDefault(t *testing.T) {
+	testCases := []struct {
+		title                string
+		defaultValue         uint64
+		expectedDefaultValue core.ByteSize
+		expectedString       string
+	}{
+		{
+			title:                "zero default value",
+			defaultValue:         0,
+			expectedDefaultValue: 0,
+			expectedString:       "0B",
+		},
+		{
+			title:                "SI default value",
+			defaultValue:         10 * core.MB,
+			expectedDefaultValue: 10 * core.MB,
+			expectedString:       "10MB",
+		},
+		{
+			title:                "IEC default value",
+			defaultValue:         3 * core.GiB / 2,
+			expectedDefaultValue: 3 * core.GiB / 2,
+			expectedString:       "1.5GiB",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSize("long", "usage").WithDefault(tc.defaultValue)
+			actual := f.Default()
+			if actual != tc.expectedDefaultValue {
+				t.Errorf("Expected Default Value: %v, Actual: %v", tc.expectedDefaultValue, actual)
+			}
+			if str := actual.(core.ByteSize).String(); str != tc.expectedString {
+				t.Errorf("Expected Default String: %s, Actual: %s", tc.expectedString, str)
+			}
+		})
+	}
+}
+
+func TestByteSizeFlag_Set(t *testing.T) {
+	testCases := []struct {
+		title         string
+		value         string
+		expectedValue uint64
+		expectedError string
+	}{
+		{
+			title:         "no value",
+			expectedValue: 0,
+		},
+		{
+			title:         "white space value",
+			value:         "   ",
+			expectedValue: 0,
+		},
+		{
+			title:         "value with white space",
+			value:         "  10MB  ",
+			expectedValue: 10 * core.MB,
+		},
+		{
+			title:         "value without unit",
+			value:         "100",
+			expectedValue: 100,
+		},
+		{
+			title:         "case insensitive unit",
+			value:         "2mib",
+			expectedValue: 2 * core.MiB,
+		},
+		{
+			title:         "decimal fraction",
+			value:         "1.5GiB",
+			expectedValue: 3 * core.GiB / 2,
+		},
+		{
+			title:         "negative value",
+			value:         "-1MB",
+			expectedError: "is not a valid bytesize value",
+			expectedValue: 0,
+		},
+		{
+			title:         "invalid unit",
+			value:         "10XB",
+			expectedError: "is not a valid bytesize value",
+			expectedValue: 0,
+		},
+		{
+			title:         "invalid value",
+			value:         "abc",
+			expectedError: "is not a valid bytesize value",
+			expectedValue: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSize("long", "usage")
+			fVar := f.Var()
+			err := f.Set(tc.value)
+			checkFlag(t, f, err, tc.expectedError, tc.expectedValue, f.Get(), fVar)
+		})
+	}
+}
+
+func TestByteSizeFlag_Validation(t *testing.T) {
+	testCases := []struct {
+		title          string
+		value          string
+		expectedValue  uint64
+		validationList []uint64
+		expectedError  string
+	}{
+		{
+			title:          "value from the validation list",
+			validationList: []uint64{1 * core.KB, 2 * core.MB},
+			value:          "2MB",
+			expectedValue:  2 * core.MB,
+		},
+		{
+			title:          "value in a different unit",
+			validationList: []uint64{1 * core.KB, 2 * core.MB},
+			value:          "2000KB",
+			expectedValue:  2 * core.MB,
+		},
+		{
+			title:          "value not from the validation list",
+			validationList: []uint64{1 * core.KB, 2 * core.MB},
+			value:          "3MB",
+			expectedError:  "3MB is not an acceptable value for --long. The expected values are 1KB,2MB.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSize("long", "usage").WithValidRange(tc.validationList...)
+			fVar := f.Var()
+			err := f.Set(tc.value)
+			checkFlag(t, f, err, tc.expectedError, tc.expectedValue, f.Get(), fVar)
+		})
+	}
+}
+
+func TestByteSizeFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.ByteSizeFlag
+		value         string
+		expectedValue uint64
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.ByteSize("long", "usage"),
+			value:         "9MB",
+			expectedValue: 9 * core.MB,
+		},
+		{
+			title:         "value equal to the inclusive lower bound",
+			flag:          flags.ByteSize("long", "usage").WithMin(1 * core.KiB),
+			value:         "1024",
+			expectedValue: 1 * core.KiB,
+			expectedRange: ">= 1KiB",
+		},
+		{
+			title:         "value less than the lower bound",
+			flag:          flags.ByteSize("long", "usage").WithMin(1 * core.KiB),
+			value:         "1KB",
+			expectedRange: ">= 1KiB",
+			expectedError: "1KB is not an acceptable value for --long. The expected range is >= 1KiB.",
+		},
+		{
+			title:         "value equal to the exclusive upper bound",
+			flag:          flags.ByteSize("long", "usage").WithMax(10*core.MB, core.Exclusive),
+			value:         "10MB",
+			expectedRange: "< 10MB",
+			expectedError: "10MB is not an acceptable value for --long. The expected range is < 10MB.",
+		},
+		{
+			title:         "value within the closed range",
+			flag:          flags.ByteSize("long", "usage").WithBetween(1*core.MB, 1*core.GB),
+			value:         "1.5MB",
+			expectedValue: 1500 * core.KB,
+			expectedRange: "[1MB, 1GB]",
+		},
+		{
+			title:         "value out of the closed range",
+			flag:          flags.ByteSize("long", "usage").WithBetween(1*core.MB, 1*core.GB),
+			value:         "1GiB",
+			expectedRange: "[1MB, 1GB]",
+			expectedError: "1GiB is not an acceptable value for --long. The expected range is [1MB, 1GB].",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
+func TestByteSizeFlag_ResetToDefault(t *testing.T) {
+	testCases := []struct {
+		title                   string
+		value                   string
+		expectedValue           uint64
+		defaultValue            uint64
+		expectedAfterResetValue uint64
+		setDefault              bool
+	}{
+		{
+			title:                   "reset without defining the default value",
+			value:                   "10MB",
+			expectedValue:           10 * core.MB,
+			expectedAfterResetValue: 10 * core.MB,
+		},
+		{
+			title:                   "reset to zero default value",
+			value:                   "10MB",
+			expectedValue:           10 * core.MB,
+			defaultValue:            0,
+			expectedAfterResetValue: 0,
+			setDefault:              true,
+		},
+		{
+			title:                   "reset to non-zero default value",
+			value:                   "10MB",
+			expectedValue:           10 * core.MB,
+			defaultValue:            1 * core.GiB,
+			expectedAfterResetValue: 1 * core.GiB,
+			setDefault:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSize("long", "usage")
+			if tc.setDefault {
+				f = f.WithDefault(tc.defaultValue)
+			}
+			fVar := f.Var()
+			err := f.Set(tc.value)
+			checkFlag(t, f, err, "", tc.expectedValue, f.Get(), fVar)
+
+			f.ResetToDefault()
+
+			if tc.setDefault && f.IsSet() {
+				t.Error("IsSet() Expected: false, Actual: true")
+			}
+
+			checkFlagValues(t, tc.expectedAfterResetValue, f.Get(), fVar)
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// ByteSizeSliceFlag represents a byte size slice flag.
+//
+// The value of a byte size slice flag can be set using a comma (or any custom delimiter) separated string of sizes.
+//
+// Each byte size string is a non-negative decimal number with an optional fraction and an optional unit suffix,
+// such as "512", "10MB" or "1.5GiB". Valid units are "B", "KB", "MB", "GB", "TB", "PB", "EB" (powers of 1000)
+// and "KiB", "MiB", "GiB", "TiB", "PiB", "EiB" (powers of 1024). The units are case insensitive.
+// For example --sizes "512KiB, 10MB, 1.5GiB".
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+type ByteSizeSliceFlag struct {
+	key                 *Key
+	defaultValue, value []uint64
+	hasDefault          bool
+	ptr                 *[]uint64
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	delimiter           string
+	validate            func(in uint64) error
+	validationList      map[uint64]interface{}
+	acceptableItems     []string
+	bounds              uintBounds
+	items               itemRules
+	validators          []Validator
+}
+
+// NewByteSizeSlice creates a new byte size slice flag.
+func NewByteSizeSlice(name, usage string) *ByteSizeSliceFlag {
+	f := &ByteSizeSliceFlag{
+		key:       &Key{},
+		long:      internal.SanitiseLongName(name),
+		usage:     usage,
+		ptr:       new([]uint64),
+		delimiter: DefaultDelimiter,
+	}
+	f.set(make([]uint64, 0))
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --sizes).
+func (f *ByteSizeSliceFlag) LongName() string {
+	return f.long
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -s).
+func (f *ByteSizeSliceFlag) WithShort(short string) *ByteSizeSliceFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *ByteSizeSliceFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *ByteSizeSliceFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *ByteSizeSliceFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *ByteSizeSliceFlag) Required() *ByteSizeSliceFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *ByteSizeSliceFlag) Type() string {
+	return "[]bytesize"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -D).
+func (f *ByteSizeSliceFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *ByteSizeSliceFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *ByteSizeSliceFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *ByteSizeSliceFlag) Var() *[]uint64 {
+	return f.ptr
+}
+
+// Get returns the current value of the flag.
+func (f *ByteSizeSliceFlag) Get() []uint64 {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *ByteSizeSliceFlag) WithKey(keyID string) *ByteSizeSliceFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *ByteSizeSliceFlag) WithDefault(defaultValue []uint64) *ByteSizeSliceFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *ByteSizeSliceFlag) Hide() *ByteSizeSliceFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *ByteSizeSliceFlag) WithGroup(name string) *ByteSizeSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *ByteSizeSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *ByteSizeSliceFlag) WithDescription(description string) *ByteSizeSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *ByteSizeSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *ByteSizeSliceFlag) WithExamples(examples ...string) *ByteSizeSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *ByteSizeSliceFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *ByteSizeSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *ByteSizeSliceFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *ByteSizeSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
+func (f *ByteSizeSliceFlag) WithDelimiter(delimiter string) *ByteSizeSliceFlag {
+	if len(delimiter) == 0 {
+		delimiter = DefaultDelimiter
+	}
+	f.delimiter = delimiter
+	return f
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// You can also define a list of acceptable values using WithValidRange(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *ByteSizeSliceFlag) WithValidationCallback(validate func(in uint64) error) *ByteSizeSliceFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *ByteSizeSliceFlag) WithValidators(validators ...Validator) *ByteSizeSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
+//
+// The set operation will fail if the flag value is not from the specified list.
+// You can also define a custom validation callback function using WithValidationCallback(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *ByteSizeSliceFlag) WithValidRange(valid ...uint64) *ByteSizeSliceFlag {
+	if len(valid) == 0 {
+		return f
+	}
+	f.validationList = make(map[uint64]interface{})
+	f.acceptableItems = make([]string, 0)
+	for _, v := range valid {
+		if _, ok := f.validationList[v]; !ok {
+			f.validationList[v] = nil
+			f.acceptableItems = append(f.acceptableItems, ByteSize(v).String())
+		}
+	}
+	return f
+}
+
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *ByteSizeSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *ByteSizeSliceFlag) WithMinItems(min int) *ByteSizeSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *ByteSizeSliceFlag) WithMaxItems(max int) *ByteSizeSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *ByteSizeSliceFlag) Unique() *ByteSizeSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *ByteSizeSliceFlag) Constraints() []Constraint {
+	return append(f.items.constraints(), describeValidators(f.validators)...)
+}
+
+// WithMin sets the lower bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMin(x, core.Exclusive)).
+// The bounds will be applied to each item of the list.
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *ByteSizeSliceFlag) WithMin(min uint64, bound ...Bound) *ByteSizeSliceFlag {
+	f.bounds.setMin(min, bound)
+	return f
+}
+
+// WithMax sets the upper bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMax(x, core.Exclusive)).
+// The bounds will be applied to each item of the list.
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *ByteSizeSliceFlag) WithMax(max uint64, bound ...Bound) *ByteSizeSliceFlag {
+	f.bounds.setMax(max, bound)
+	return f
+}
+
+// WithBetween sets the lower and the upper bounds of the acceptable values.
+//
+// Both bounds are inclusive, unless core.Exclusive has been specified (i.e. WithBetween(x, y, core.Exclusive)).
+// Use WithMin(...) and WithMax(...) methods to define half-open ranges.
+func (f *ByteSizeSliceFlag) WithBetween(min, max uint64, bound ...Bound) *ByteSizeSliceFlag {
+	return f.WithMin(min, bound...).WithMax(max, bound...)
+}
+
+// Range returns the bounds of the acceptable values, or nil if the flag is unbounded.
+//
+// The bounds can be defined using WithMin(...), WithMax(...) or WithBetween(...) methods.
+func (f *ByteSizeSliceFlag) Range() *Range {
+	return f.bounds.toRange(formatByteSize)
+}
+
+// Set sets the flag value.
+//
+// The value of a byte size slice flag can be set using a comma (or any custom delimiter) separated string of sizes.
+//
+// Each byte size string is a non-negative decimal number with an optional fraction and an optional unit suffix,
+// such as "512", "10MB" or "1.5GiB". Valid units are "B", "KB", "MB", "GB", "TB", "PB", "EB" (powers of 1000)
+// and "KiB", "MiB", "GiB", "TiB", "PiB", "EiB" (powers of 1024). The units are case insensitive.
+// For example --sizes "512KiB, 10MB, 1.5GiB".
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func (f *ByteSizeSliceFlag) Set(value string) error {
+	parts := strings.Split(strings.TrimSpace(value), f.delimiter)
+	list := make([]uint64, 0)
+	for _, v := range parts {
+		value = strings.TrimSpace(v)
+		if internal.IsEmpty(v) {
+			continue
+		}
+		item, err := ParseByteSize(value)
+		if err != nil {
+			return internal.InvalidValueErr(value, f.long, f.short, f.Type())
+		}
+
+		if err := f.validateItem(uint64(item), value); err != nil {
+			return err
+		}
+
+		list = append(list, uint64(item))
+	}
+
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
+	f.set(list)
+	f.isSet = true
+	return nil
+}
+
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *ByteSizeSliceFlag) validateItem(item uint64, input interface{}) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil && !f.bounds.contains(item) {
+		return internal.OutOfBoundsErr(input, f.long, f.short, f.Range().String())
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *ByteSizeSliceFlag) validateItems(list []uint64) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return ByteSize(list[i]).String()
+	})
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *ByteSizeSliceFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	f.set(f.defaultValue)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The default value will be returned as a list of ByteSize values, so that it will be printed in human-readable format.
+// The default value can be defined using WithDefault(...) method.
+func (f *ByteSizeSliceFlag) Default() interface{} {
+	if !f.hasDefault {
+		return nil
+	}
+	sizes := make([]ByteSize, len(f.defaultValue))
+	for i, v := range f.defaultValue {
+		sizes[i] = ByteSize(v)
+	}
+	return sizes
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *ByteSizeSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item, ByteSize(item)); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *ByteSizeSliceFlag) Key() *Key {
+	return f.key
+}
+
+func (f *ByteSizeSliceFlag) set(value []uint64) {
+	f.value = value
+	*f.ptr = value
+}
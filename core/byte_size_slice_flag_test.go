@@ -0,0 +1,126 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+)
+
+func TestByteSizeSlice(t *testing.T) {
+	f := flags.ByteSizeSlice("LONG", "usage")
+	checkFlagInitialState(t, f, "[]bytesize", "usage", "long", "")
+	checkSliceFlagValues(t, []uint64{}, f.Get(), f.Var())
+}
+
+func TestByteSizeSliceFlag_WithDefault(t *testing.T) {
+	f := flags.ByteSizeSlice("long", "usage").WithDefault([]uint64{10 * core.MB, 1 * core.GiB})
+	expected := []core.ByteSize{10 * core.MB, 1 * core.GiB}
+	checkSliceFlagValues(t, expected, f.Default(), &expected)
+}
+
+func TestByteSizeSliceFlag_Set(t *testing.T) {
+	empty := make([]uint64, 0)
+	testCases := []struct {
+		title         string
+		value         string
+		delimiter     string
+		expectedValue []uint64
+		expectedError string
+	}{
+		{
+			title:         "empty value",
+			value:         "",
+			expectedValue: empty,
+		},
+		{
+			title:         "white space value",
+			value:         "   ",
+			expectedValue: empty,
+		},
+		{
+			title:         "single value with white space",
+			value:         "  2MB  ",
+			expectedValue: []uint64{2 * core.MB},
+		},
+		{
+			title:         "comma separated value with mixed units",
+			value:         " 512, 1.5KiB   ,   2gb ",
+			expectedValue: []uint64{512, 1536, 2 * core.GB},
+		},
+		{
+			title:         "custom delimiter",
+			value:         "1MB|2MiB",
+			delimiter:     "|",
+			expectedValue: []uint64{1 * core.MB, 2 * core.MiB},
+		},
+		{
+			title:         "comma separated white space string",
+			value:         " , , ",
+			expectedValue: empty,
+		},
+		{
+			title:         "partially invalid value",
+			value:         "2MB,invalid,3MB",
+			expectedError: "'invalid' is not a valid []bytesize value for --long",
+			expectedValue: empty,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.ByteSizeSlice("long", "usage")
+			if tc.delimiter != "" {
+				f = f.WithDelimiter(tc.delimiter)
+			}
+			fVar := f.Var()
+			err := f.Set(tc.value)
+			checkSliceFlag(t, f, err, tc.expectedError, tc.expectedValue, f.Get(), fVar)
+		})
+	}
+}
+
+func TestByteSizeSliceFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.ByteSizeSliceFlag
+		value         string
+		expectedValue []uint64
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "all the items within the closed range",
+			flag:          flags.ByteSizeSlice("long", "usage").WithBetween(1*core.MB, 1*core.GB),
+			value:         "1MB,1GB",
+			expectedValue: []uint64{1 * core.MB, 1 * core.GB},
+			expectedRange: "[1MB, 1GB]",
+		},
+		{
+			title:         "an item out of the closed range",
+			flag:          flags.ByteSizeSlice("long", "usage").WithBetween(1*core.MB, 1*core.GB),
+			value:         "1MB,1GiB",
+			expectedValue: []uint64{},
+			expectedRange: "[1MB, 1GB]",
+			expectedError: "1GiB is not an acceptable value for --long. The expected range is [1MB, 1GB].",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
+func TestByteSizeSliceFlag_Unique(t *testing.T) {
+	f := flags.ByteSizeSlice("long", "usage").Unique()
+	err := f.Set("1KiB,1024")
+	if err == nil {
+		t.Error("Expected the duplicate sizes to be rejected, but received no errors")
+	}
+}
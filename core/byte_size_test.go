@@ -0,0 +1,178 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestParseByteSize(t *testing.T) {
+	testCases := []struct {
+		title         string
+		value         string
+		expectedValue core.ByteSize
+		expectError   bool
+	}{
+		{
+			title:         "zero",
+			value:         "0",
+			expectedValue: 0,
+		},
+		{
+			title:         "no unit",
+			value:         "512",
+			expectedValue: 512,
+		},
+		{
+			title:         "bytes",
+			value:         "512B",
+			expectedValue: 512,
+		},
+		{
+			title:         "SI unit",
+			value:         "10MB",
+			expectedValue: 10 * core.MB,
+		},
+		{
+			title:         "IEC unit",
+			value:         "10MiB",
+			expectedValue: 10 * core.MiB,
+		},
+		{
+			title:         "lowercase unit",
+			value:         "2kib",
+			expectedValue: 2 * core.KiB,
+		},
+		{
+			title:         "mixed case unit",
+			value:         "2gB",
+			expectedValue: 2 * core.GB,
+		},
+		{
+			title:         "decimal fraction",
+			value:         "1.5GiB",
+			expectedValue: 3 * core.GiB / 2,
+		},
+		{
+			title:         "fraction with no integer part",
+			value:         ".5MB",
+			expectedValue: 500 * core.KB,
+		},
+		{
+			title:         "fractions of a byte will be discarded",
+			value:         "1.5B",
+			expectedValue: 1,
+		},
+		{
+			title:         "white space between the number and the unit",
+			value:         "  10 KB  ",
+			expectedValue: 10 * core.KB,
+		},
+		{
+			title:         "largest value",
+			value:         "15EiB",
+			expectedValue: 15 * core.EiB,
+		},
+		{
+			title:       "empty value",
+			value:       "",
+			expectError: true,
+		},
+		{
+			title:       "negative value",
+			value:       "-1MB",
+			expectError: true,
+		},
+		{
+			title:       "unknown unit",
+			value:       "10XB",
+			expectError: true,
+		},
+		{
+			title:       "unit without number",
+			value:       "MB",
+			expectError: true,
+		},
+		{
+			title:       "overflow",
+			value:       "16EiB",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual, err := core.ParseByteSize(tc.value)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error, but received nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Expected no errors, but received %s", err)
+			}
+			if actual != tc.expectedValue {
+				t.Errorf("Expected value: %d, Actual: %d", tc.expectedValue, actual)
+			}
+		})
+	}
+}
+
+func TestByteSize_String(t *testing.T) {
+	testCases := []struct {
+		title    string
+		size     core.ByteSize
+		expected string
+	}{
+		{
+			title:    "zero",
+			size:     0,
+			expected: "0B",
+		},
+		{
+			title:    "less than a kilobyte",
+			size:     512,
+			expected: "512B",
+		},
+		{
+			title:    "SI unit",
+			size:     10 * core.MB,
+			expected: "10MB",
+		},
+		{
+			title:    "IEC unit",
+			size:     10 * core.MiB,
+			expected: "10MiB",
+		},
+		{
+			title:    "decimal fraction",
+			size:     3 * core.GiB / 2,
+			expected: "1.5GiB",
+		},
+		{
+			title:    "two decimal places",
+			size:     1250 * core.KB,
+			expected: "1.25MB",
+		},
+		{
+			title:    "not representable with two decimal places",
+			size:     1234,
+			expected: "1234B",
+		},
+		{
+			title:    "largest unit",
+			size:     2 * core.EiB,
+			expected: "2EiB",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := tc.size.String()
+			if actual != tc.expected {
+				t.Errorf("Expected: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
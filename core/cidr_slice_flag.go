@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/xitonix/flags/internal"
@@ -21,13 +22,18 @@ type CIDRSliceFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	delimiter           string
 	validate            func(in CIDR) error
 	validationList      map[string]interface{}
 	acceptableItems     []string
+	items               itemRules
+	validators          []Validator
 }
 
 // NewCIDRSlice creates a new CIDR (Classless Inter-Domain Routing) slice flag.
@@ -59,7 +65,7 @@ func (f *CIDRSliceFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *CIDRSliceFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // WithShort sets the short name of the flag.
@@ -152,21 +158,67 @@ func (f *CIDRSliceFlag) Hide() *CIDRSliceFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *CIDRSliceFlag) WithGroup(name string) *CIDRSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *CIDRSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *CIDRSliceFlag) WithDescription(description string) *CIDRSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *CIDRSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *CIDRSliceFlag) WithExamples(examples ...string) *CIDRSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *CIDRSliceFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *CIDRSliceFlag) MarkAsDeprecated() *CIDRSliceFlag {
-	f.isDeprecated = true
+func (f *CIDRSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *CIDRSliceFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *CIDRSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
 func (f *CIDRSliceFlag) WithDelimiter(delimiter string) *CIDRSliceFlag {
 	if len(delimiter) == 0 {
@@ -186,6 +238,16 @@ func (f *CIDRSliceFlag) WithValidationCallback(validate func(in CIDR) error) *CI
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *CIDRSliceFlag) WithValidators(validators ...Validator) *CIDRSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -210,6 +272,36 @@ func (f *CIDRSliceFlag) WithValidRange(valid ...CIDR) *CIDRSliceFlag {
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *CIDRSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *CIDRSliceFlag) WithMinItems(min int) *CIDRSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *CIDRSliceFlag) WithMaxItems(max int) *CIDRSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *CIDRSliceFlag) Unique() *CIDRSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *CIDRSliceFlag) Constraints() []Constraint {
+	return append(f.items.constraints(), describeValidators(f.validators)...)
+}
+
 // Set sets the flag value.
 //
 // The value of a CIDR slice flag can be defined using a list of CIDR notation IP addresses and prefix length,
@@ -228,28 +320,54 @@ func (f *CIDRSliceFlag) Set(value string) error {
 			return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 		}
 
-		if f.validate != nil {
-			err := f.validate(*cidr)
-			if err != nil {
-				return err
-			}
-		}
-
-		// Validation callback takes priority over validation list
-		if f.validate == nil && len(f.validationList) > 0 {
-			if _, ok := f.validationList[cidr.String()]; !ok {
-				return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
-			}
+		if err := f.validateItem(*cidr, value); err != nil {
+			return err
 		}
 
 		list = append(list, *cidr)
 	}
 
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
 	f.set(list)
 	f.isSet = true
 	return nil
 }
 
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *CIDRSliceFlag) validateItem(item CIDR, input interface{}) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item.String()]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *CIDRSliceFlag) validateItems(list []CIDR) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return fmt.Sprint(list[i])
+	})
+}
+
 // ResetToDefault resets the value of this flag to default if a default value is specified.
 //
 // Calling this method on a flag without a default value will have no effect.
@@ -273,6 +391,19 @@ func (f *CIDRSliceFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *CIDRSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item, item); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
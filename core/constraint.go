@@ -0,0 +1,69 @@
+package core
+
+import "strings"
+
+// Constraint represents a human-readable restriction on the value of a flag (i.e. length: [3, 10]).
+type Constraint struct {
+	// Message is the identifier of the message which describes the constraint (i.e. core.MsgLength).
+	//
+	// Any text which is not a message identifier will be used as the description itself.
+	Message string
+	// Args is the list of the arguments of the message.
+	Args []interface{}
+}
+
+// NewConstraint creates a new constraint.
+func NewConstraint(message string, args ...interface{}) Constraint {
+	return Constraint{
+		Message: message,
+		Args:    args,
+	}
+}
+
+// String returns the English description of the constraint.
+func (c Constraint) String() string {
+	return c.Format(nil)
+}
+
+// Format returns the description of the constraint, translated using the message catalogue.
+//
+// The nested constraints within the arguments (i.e. the constraints of validate.Not(...)) will also be translated.
+func (c Constraint) Format(catalogue MessageCatalogue) string {
+	args := make([]interface{}, len(c.Args))
+	for i, arg := range c.Args {
+		switch a := arg.(type) {
+		case Constraint:
+			args[i] = a.Format(catalogue)
+		case []Constraint:
+			args[i] = formatConstraints(a, catalogue)
+		default:
+			args[i] = arg
+		}
+	}
+	return messageOf(catalogue, c.Message, args...)
+}
+
+// ConstraintProvider is the interface for the flags whose values are restricted by declarative validators.
+type ConstraintProvider interface {
+	// Constraints returns the restrictions on the flag value, or an empty list if the flag is unrestricted.
+	Constraints() []Constraint
+}
+
+// ConstraintsOf returns the restrictions on the value of the flag.
+//
+// The method returns nil if the flag does not implement ConstraintProvider interface.
+func ConstraintsOf(f Flag) []Constraint {
+	if p, ok := f.(ConstraintProvider); ok {
+		return p.Constraints()
+	}
+	return nil
+}
+
+// formatConstraints returns the translated descriptions of the constraints, separated by comma.
+func formatConstraints(constraints []Constraint, catalogue MessageCatalogue) string {
+	descriptions := make([]string, len(constraints))
+	for i, c := range constraints {
+		descriptions[i] = c.Format(catalogue)
+	}
+	return strings.Join(descriptions, ", ")
+}
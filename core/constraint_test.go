@@ -0,0 +1,77 @@
+package core_test
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func TestConstraint_Format(t *testing.T) {
+	c := core.NewConstraint(core.MsgLength, &core.Range{Min: "3", Max: "10"})
+	if actual := c.String(); actual != "length: [3, 10]" {
+		t.Errorf("Expected: 'length: [3, 10]', Actual: '%s'", actual)
+	}
+	if actual := c.Format(core.MessageMap{core.MsgLength: "Länge: %s"}); actual != "Länge: [3, 10]" {
+		t.Errorf("Expected: 'Länge: [3, 10]', Actual: '%s'", actual)
+	}
+
+	c = core.NewConstraint("must be even")
+	if actual := c.Format(nil); actual != "must be even" {
+		t.Errorf("Expected the custom description to be used as is, Actual: '%s'", actual)
+	}
+}
+
+func TestConstraintsOf(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     core.Flag
+		expected []string
+	}{
+		{
+			title: "flag without constraint provider",
+			flag:  mocks.NewFlag("long", "l"),
+		},
+		{
+			title:    "unrestricted string flag",
+			flag:     core.NewString("long", "usage"),
+			expected: []string{},
+		},
+		{
+			title: "string flag",
+			flag: core.NewString("long", "usage").
+				WithFormat(core.IdentifierFormat).
+				WithPattern(regexp.MustCompile("^[a-z]+$")).
+				WithMaxLength(10).
+				NonEmpty(),
+			expected: []string{"non-empty", "length: <= 10", "pattern: ^[a-z]+$", "format: identifier"},
+		},
+		{
+			title:    "string slice flag",
+			flag:     core.NewStringSlice("long", "usage").WithFormat(core.EmailFormat).WithMinItems(1).WithMaxItems(3).Unique(),
+			expected: []string{"format: email address", "items: [1, 3]", "unique"},
+		},
+		{
+			title:    "ip address slice flag",
+			flag:     core.NewIPAddressSlice("long", "usage").WithMinItems(2),
+			expected: []string{"items: >= 2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			var actual []string
+			if constraints := core.ConstraintsOf(tc.flag); constraints != nil {
+				actual = make([]string, len(constraints))
+				for i, c := range constraints {
+					actual[i] = c.String()
+				}
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected constraints: %v, Actual: %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package core
+
+// DefaultValidator is the interface for the flags whose default values can be checked against their validation rules.
+//
+// The default values of all the flags will be validated when the bucket is being initialised.
+type DefaultValidator interface {
+	// ValidateDefault returns an error if the default value of the flag is not acceptable.
+	//
+	// The method returns nil if no default value has been specified.
+	ValidateDefault() error
+}
+
+// ValidateDefaultOf checks the default value of the flag against its validation rules.
+//
+// The method returns nil if the flag does not implement DefaultValidator interface.
+func ValidateDefaultOf(f Flag) error {
+	if v, ok := f.(DefaultValidator); ok {
+		return v.ValidateDefault()
+	}
+	return nil
+}
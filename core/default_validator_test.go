@@ -0,0 +1,98 @@
+package core_test
+
+import (
+	"net"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+	"github.com/xitonix/flags/test"
+	"github.com/xitonix/flags/validate"
+)
+
+func TestValidateDefaultOf(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          core.Flag
+		expectedError string
+	}{
+		{
+			title: "flag without default validator",
+			flag:  mocks.NewFlag("long", "l"),
+		},
+		{
+			title: "flag without default value",
+			flag:  core.NewInt("long", "usage").WithValidRange(1, 2),
+		},
+		{
+			title: "acceptable default value",
+			flag:  core.NewInt("long", "usage").WithValidRange(1, 2).WithDefault(2),
+		},
+		{
+			title:         "default value outside of the valid range",
+			flag:          core.NewInt("long", "usage").WithShort("l").WithValidRange(1, 2).WithDefault(3),
+			expectedError: "3 is not an acceptable value for -l, --long. The expected values are 1,2.",
+		},
+		{
+			title:         "default value outside of the bounds",
+			flag:          core.NewDuration("long", "usage").WithMin(time.Second).WithDefault(time.Millisecond),
+			expectedError: "1ms is not an acceptable value for --long. The expected range is >= 1s.",
+		},
+		{
+			title:         "default value rejected by the validation callback",
+			flag:          core.NewFloat64("long", "usage").WithValidationCallback(func(in float64) error { return core.ErrEmptyFlagName }).WithDefault(1),
+			expectedError: core.ErrEmptyFlagName.Error(),
+		},
+		{
+			title:         "default value rejected by the validators",
+			flag:          core.NewUInt8("long", "usage").WithValidators(validate.Not(validate.OneOf(0))).WithDefault(0),
+			expectedError: "0 is not an acceptable value for --long: the value must not satisfy one of: 0.",
+		},
+		{
+			title:         "default value not matching the pattern",
+			flag:          core.NewString("long", "usage").WithPattern(regexp.MustCompile(`^v\d+$`)).WithDefault("latest"),
+			expectedError: `'latest' is not an acceptable value for --long. The value must match the ^v\d+$ pattern.`,
+		},
+		{
+			title:         "default item outside of the valid range",
+			flag:          core.NewStringSlice("long", "usage").WithValidRange(false, "a", "b").WithDefault([]string{"a", "c"}),
+			expectedError: "c is not an acceptable value for --long. The expected values are a,b.",
+		},
+		{
+			title:         "too many default items",
+			flag:          core.NewIntSlice("long", "usage").WithMaxItems(1).WithDefault([]int{1, 2}),
+			expectedError: "2 items are not acceptable for --long. The expected number of items is <= 1.",
+		},
+		{
+			title:         "duplicate default items",
+			flag:          core.NewIPAddressSlice("long", "usage").Unique().WithDefault([]net.IP{net.IPv4(10, 0, 0, 1), net.IPv4(10, 0, 0, 1)}),
+			expectedError: "'10.0.0.1' has been provided more than once for --long. The items must be unique.",
+		},
+		{
+			title:         "default map value rejected by the validators",
+			flag:          core.NewStringMap("long", "usage").WithValidators(validate.OneOf("on", "off")).WithDefault(map[string]string{"debug": "yes"}),
+			expectedError: "yes is not an acceptable value for --long: the expected values are on, off.",
+		},
+		{
+			title: "default time outside of the valid range",
+			flag: core.NewTime("long", "usage").
+				WithValidRange(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+				WithDefault(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+			expectedError: "01-01-2021T00:00:00 is not an acceptable value for --long. You must pick a value from 01-01-2020T00:00:00.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := core.ValidateDefaultOf(tc.flag)
+			if !test.ErrorContainsExact(err, tc.expectedError) {
+				t.Errorf("Expected error: '%s', Actual: '%v'", tc.expectedError, err)
+			}
+			if tc.flag.IsSet() {
+				t.Error("Validating the default value must not set the flag")
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// Deprecation holds the details of a deprecated flag.
+type Deprecation struct {
+	// Message is an optional message to explain why the flag has been deprecated.
+	Message string
+	// Replacement is the long name of the flag which must be used instead of the deprecated flag (if any).
+	Replacement string
+	// ForwardValue is true if the value of the deprecated flag must be forwarded to its replacement.
+	//
+	// The value will only be forwarded if the replacement flag has not been set by any of the sources.
+	ForwardValue bool
+}
+
+// DeprecationOption represents a deprecation option function.
+type DeprecationOption func(d *Deprecation)
+
+// WithDeprecationMessage sets the message which explains why the flag has been deprecated.
+//
+// The message will be printed along with the deprecation warning when the flag value is provided by a source.
+func WithDeprecationMessage(message string) DeprecationOption {
+	return func(d *Deprecation) {
+		d.Message = strings.TrimSpace(message)
+	}
+}
+
+// WithReplacement sets the long name of the flag which must be used instead of the deprecated flag.
+//
+// The replacement flag must be registered within the same bucket.
+func WithReplacement(longName string) DeprecationOption {
+	return func(d *Deprecation) {
+		d.Replacement = internal.SanitiseLongName(longName)
+	}
+}
+
+// ForwardValue enables forwarding the value of the deprecated flag to its replacement.
+//
+// The value will only be forwarded if the replacement flag has not been set by any of the sources.
+// This option will have no effect if no replacement has been specified.
+func ForwardValue() DeprecationOption {
+	return func(d *Deprecation) {
+		d.ForwardValue = true
+	}
+}
+
+// DeprecationProvider is the interface for the flags which can provide the details of their deprecation.
+//
+// All the built-in flag types implement this interface.
+type DeprecationProvider interface {
+	// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+	Deprecation() *Deprecation
+}
+
+func newDeprecation(options []DeprecationOption) *Deprecation {
+	d := &Deprecation{}
+	for _, option := range options {
+		option(d)
+	}
+	return d
+}
@@ -0,0 +1,112 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestDeprecationOptions(t *testing.T) {
+	testCases := []struct {
+		title                string
+		options              []core.DeprecationOption
+		expectedMessage      string
+		expectedReplacement  string
+		expectedForwardValue bool
+	}{
+		{
+			title: "no options",
+		},
+		{
+			title:           "with message",
+			options:         []core.DeprecationOption{core.WithDeprecationMessage("  message  ")},
+			expectedMessage: "message",
+		},
+		{
+			title:               "with replacement",
+			options:             []core.DeprecationOption{core.WithReplacement(" New-Flag ")},
+			expectedReplacement: "new-flag",
+		},
+		{
+			title:                "with value forwarding",
+			options:              []core.DeprecationOption{core.WithReplacement("new"), core.ForwardValue()},
+			expectedReplacement:  "new",
+			expectedForwardValue: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := core.NewString("long", "usage")
+			if f.Deprecation() != nil {
+				t.Error("Expected nil deprecation details before calling MarkAsDeprecated()")
+			}
+			f.MarkAsDeprecated(tc.options...)
+			if !f.IsDeprecated() {
+				t.Error("Expected IsDeprecated: true, Actual: false")
+			}
+			d := f.Deprecation()
+			if d == nil {
+				t.Fatal("Expected non nil deprecation details")
+			}
+			if d.Message != tc.expectedMessage {
+				t.Errorf("Expected Message: '%s', Actual: '%s'", tc.expectedMessage, d.Message)
+			}
+			if d.Replacement != tc.expectedReplacement {
+				t.Errorf("Expected Replacement: '%s', Actual: '%s'", tc.expectedReplacement, d.Replacement)
+			}
+			if d.ForwardValue != tc.expectedForwardValue {
+				t.Errorf("Expected ForwardValue: %v, Actual: %v", tc.expectedForwardValue, d.ForwardValue)
+			}
+		})
+	}
+}
+
+func TestDeprecationWarning_Error(t *testing.T) {
+	testCases := []struct {
+		title       string
+		long, short string
+		deprecation *core.Deprecation
+		expected    string
+	}{
+		{
+			title:    "nil deprecation details",
+			long:     "long",
+			expected: "--long is deprecated.",
+		},
+		{
+			title:       "with short name",
+			long:        "long",
+			short:       "l",
+			deprecation: &core.Deprecation{},
+			expected:    "-l, --long is deprecated.",
+		},
+		{
+			title:       "with message",
+			long:        "long",
+			deprecation: &core.Deprecation{Message: "It will be removed soon."},
+			expected:    "--long is deprecated. It will be removed soon.",
+		},
+		{
+			title:       "with replacement",
+			long:        "long",
+			deprecation: &core.Deprecation{Replacement: "new"},
+			expected:    "--long is deprecated. Use --new instead.",
+		},
+		{
+			title:       "with message and replacement",
+			long:        "long",
+			deprecation: &core.Deprecation{Message: "It will be removed soon.", Replacement: "new"},
+			expected:    "--long is deprecated. It will be removed soon. Use --new instead.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			w := core.NewDeprecationWarning(tc.long, tc.short, tc.deprecation)
+			if w.Error() != tc.expected {
+				t.Errorf("Expected: '%s', Actual: '%s'", tc.expected, w.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package core
+
+import "github.com/xitonix/flags/internal"
+
+// DeprecationWarning is reported to the bucket's logger when the value of a deprecated flag has been provided by a source.
+type DeprecationWarning struct {
+	long, short string
+	text        string
+	replacement string
+	message     func(id string, args ...interface{}) string
+}
+
+// NewDeprecationWarning creates a new instance of DeprecationWarning.
+func NewDeprecationWarning(long, short string, deprecation *Deprecation) *DeprecationWarning {
+	w := &DeprecationWarning{
+		long:  long,
+		short: short,
+	}
+	if deprecation != nil {
+		w.text = deprecation.Message
+		w.replacement = deprecation.Replacement
+	}
+	return w
+}
+
+// Replacement returns the long name of the replacement flag (if any).
+func (w *DeprecationWarning) Replacement() string {
+	return w.replacement
+}
+
+// Error returns the string representation of a DeprecationWarning.
+func (w *DeprecationWarning) Error() string {
+	if w.message == nil {
+		return w.format(internal.EnglishMessage)
+	}
+	return w.format(w.message)
+}
+
+// Localise translates the warning using the specified message function (i.e. catalogue.Message).
+func (w *DeprecationWarning) Localise(message func(id string, args ...interface{}) string) {
+	w.message = message
+}
+
+func (w *DeprecationWarning) format(message func(id string, args ...interface{}) string) string {
+	msg := message(MsgDeprecated, internal.GetPrintName(w.long, w.short))
+	if !internal.IsEmpty(w.text) {
+		msg += " " + w.text
+	}
+	if !internal.IsEmpty(w.replacement) {
+		msg += " " + message(MsgUseReplacement, w.replacement)
+	}
+	return msg
+}
@@ -0,0 +1,386 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// DirPathFlag represents a directory path flag.
+//
+// The value of a directory path flag will be resolved to an absolute path. The leading ~ and the environment variables
+// within the path can optionally be expanded using ExpandHome() and ExpandEnv() methods.
+// If the path exists, it must be a directory.
+type DirPathFlag struct {
+	key                 *Key
+	defaultValue, value string
+	hasDefault          bool
+	ptr                 *string
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	validate            func(in string) error
+	rules               pathRules
+	validators          []Validator
+}
+
+// NewDirPath creates a new directory path flag.
+func NewDirPath(name, usage string) *DirPathFlag {
+	f := &DirPathFlag{
+		key:   &Key{},
+		long:  internal.SanitiseLongName(name),
+		usage: usage,
+		ptr:   new(string),
+		rules: pathRules{isDir: true},
+	}
+	f.set("")
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --output-dir).
+func (f *DirPathFlag) LongName() string {
+	return f.long
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -o).
+func (f *DirPathFlag) WithShort(short string) *DirPathFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *DirPathFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *DirPathFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *DirPathFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *DirPathFlag) Required() *DirPathFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *DirPathFlag) Type() string {
+	return "dir"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -O).
+func (f *DirPathFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *DirPathFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *DirPathFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *DirPathFlag) Var() *string {
+	return f.ptr
+}
+
+// Get returns the current value of the flag.
+//
+// The value is always an absolute path, unless the flag has been set to an empty string.
+func (f *DirPathFlag) Get() string {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *DirPathFlag) WithKey(keyID string) *DirPathFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *DirPathFlag) WithDefault(defaultValue string) *DirPathFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *DirPathFlag) Hide() *DirPathFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *DirPathFlag) WithGroup(name string) *DirPathFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *DirPathFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *DirPathFlag) WithDescription(description string) *DirPathFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *DirPathFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *DirPathFlag) WithExamples(examples ...string) *DirPathFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *DirPathFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *DirPathFlag) MarkAsDeprecated(options ...DeprecationOption) *DirPathFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *DirPathFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// The callback will be called with the resolved absolute path.
+// Remember that the existence and the permission checks will have no effect if a validation callback has been specified.
+func (f *DirPathFlag) WithValidationCallback(validate func(in string) error) *DirPathFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *DirPathFlag) WithValidators(validators ...Validator) *DirPathFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *DirPathFlag) Constraints() []Constraint {
+	return append(f.rules.constraints(), describeValidators(f.validators)...)
+}
+
+// ExpandHome enables the expansion of the leading ~ to the home directory of the current user (i.e. ~/.config).
+func (f *DirPathFlag) ExpandHome() *DirPathFlag {
+	f.rules.expandHome = true
+	return f
+}
+
+// ExpandEnv enables the expansion of the environment variables within the path (i.e. $HOME/.config or ${XDG_CONFIG_HOME}).
+func (f *DirPathFlag) ExpandEnv() *DirPathFlag {
+	f.rules.expandEnv = true
+	return f
+}
+
+// MustExist rejects the paths which do not exist.
+func (f *DirPathFlag) MustExist() *DirPathFlag {
+	f.rules.mustExist = true
+	return f
+}
+
+// MustNotExist rejects the paths which already exist.
+func (f *DirPathFlag) MustNotExist() *DirPathFlag {
+	f.rules.mustNotExist = true
+	return f
+}
+
+// Readable rejects the paths which do not exist or cannot be read by the current user.
+func (f *DirPathFlag) Readable() *DirPathFlag {
+	f.rules.readable = true
+	return f
+}
+
+// Writable rejects the paths which cannot be written by the current user.
+//
+// If the path does not exist, its parent directory must be writable.
+func (f *DirPathFlag) Writable() *DirPathFlag {
+	f.rules.writable = true
+	return f
+}
+
+// ValueHint returns the hint which will be used by the shell completion generators to suggest the directories.
+func (f *DirPathFlag) ValueHint() ValueHint {
+	return DirectoryHint
+}
+
+// Set sets the flag value.
+//
+// The path will be resolved to an absolute path. The leading ~ and the environment variables
+// will be expanded first, if enabled.
+func (f *DirPathFlag) Set(value string) error {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		f.set("")
+		f.isSet = true
+		return nil
+	}
+	path, err := f.rules.resolve(value)
+	if err != nil {
+		return internal.InvalidValueErr(value, f.long, f.short, f.Type())
+	}
+
+	if err := f.validateValue(path, value); err != nil {
+		return err
+	}
+
+	f.set(path)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the resolved path against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *DirPathFlag) validateValue(path, input string) error {
+	if f.validate != nil {
+		err := f.validate(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(path, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over the declarative rules
+	if f.validate == nil {
+		return f.rules.check(path, input, f.long, f.short)
+	}
+
+	return nil
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// The default path will be resolved the same way as the values provided by the sources.
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *DirPathFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	path := strings.TrimSpace(f.defaultValue)
+	if len(path) > 0 {
+		if resolved, err := f.rules.resolve(path); err == nil {
+			path = resolved
+		}
+	}
+	f.set(path)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The default value can be defined using WithDefault(...) method.
+func (f *DirPathFlag) Default() interface{} {
+	if !f.hasDefault {
+		return nil
+	}
+
+	return f.defaultValue
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+//
+// The file system checks (i.e. MustExist()) will not be applied to the default value, since the default path
+// may legitimately be missing when the value is provided by one of the sources.
+func (f *DirPathFlag) ValidateDefault() error {
+	if !f.hasDefault || f.validate != nil || internal.IsEmpty(f.defaultValue) {
+		return nil
+	}
+	return f.rules.checkExtension(f.defaultValue, f.defaultValue, f.long, f.short)
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *DirPathFlag) Key() *Key {
+	return f.key
+}
+
+func (f *DirPathFlag) set(value string) {
+	f.value = value
+	*f.ptr = value
+}
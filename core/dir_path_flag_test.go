@@ -0,0 +1,77 @@
+package core_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+)
+
+func TestDirPath(t *testing.T) {
+	f := flags.DirPath("LONG", "usage")
+	checkFlagInitialState(t, f, "dir", "usage", "long", "")
+	checkFlagValues(t, "", f.Get(), f.Var())
+	if hint := f.ValueHint(); hint != core.DirectoryHint {
+		t.Errorf("Expected Value Hint: %v, Actual: %v", core.DirectoryHint, hint)
+	}
+}
+
+func TestDirPathFlag_Set(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	home, _ := os.UserHomeDir()
+
+	testCases := []struct {
+		title         string
+		flag          *core.DirPathFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title:         "home directory",
+			flag:          flags.DirPath("long", "usage").ExpandHome(),
+			value:         "~",
+			expectedValue: home,
+		},
+		{
+			title:         "existing directory",
+			flag:          flags.DirPath("long", "usage").MustExist().Readable().Writable(),
+			value:         filepath.Join(dir, "sub"),
+			expectedValue: filepath.Join(dir, "sub"),
+		},
+		{
+			title:         "missing directory",
+			flag:          flags.DirPath("long", "usage").WithShort("o").MustExist(),
+			value:         "missing",
+			expectedError: "'missing' is not an acceptable value for -o, --long. The path does not exist.",
+		},
+		{
+			title:         "missing directory within a writable parent",
+			flag:          flags.DirPath("long", "usage").MustNotExist().Writable(),
+			value:         filepath.Join(dir, "new"),
+			expectedValue: filepath.Join(dir, "new"),
+		},
+		{
+			title:         "existing directory must not exist",
+			flag:          flags.DirPath("long", "usage").MustNotExist(),
+			value:         filepath.Join(dir, "sub"),
+			expectedError: "is not an acceptable value for --long. The path already exists.",
+		},
+		{
+			title:         "file",
+			flag:          flags.DirPath("long", "usage"),
+			value:         filepath.Join(dir, "config.yaml"),
+			expectedError: "is not an acceptable value for --long. The path is not a directory.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
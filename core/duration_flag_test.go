@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestDuration(t *testing.T) {
@@ -483,6 +484,104 @@ func TestDurationFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestDurationFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.DurationFlag
+		value         string
+		expectedValue time.Duration
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.Duration("long", "usage"),
+			value:         "9s",
+			expectedValue: 9 * time.Second,
+		},
+		{
+			title:         "value equal to the inclusive lower bound",
+			flag:          flags.Duration("long", "usage").WithMin(10 * time.Second),
+			value:         "10s",
+			expectedValue: 10 * time.Second,
+			expectedRange: ">= 10s",
+		},
+		{
+			title:         "value less than the lower bound",
+			flag:          flags.Duration("long", "usage").WithMin(10 * time.Second),
+			value:         "9s",
+			expectedRange: ">= 10s",
+			expectedError: "9s is not an acceptable value for --long. The expected range is >= 10s.",
+		},
+		{
+			title:         "value equal to the exclusive lower bound",
+			flag:          flags.Duration("long", "usage").WithMin(10*time.Second, core.Exclusive),
+			value:         "10s",
+			expectedRange: "> 10s",
+			expectedError: "10s is not an acceptable value for --long. The expected range is > 10s.",
+		},
+		{
+			title:         "value equal to the inclusive upper bound",
+			flag:          flags.Duration("long", "usage").WithMax(20 * time.Second),
+			value:         "20s",
+			expectedValue: 20 * time.Second,
+			expectedRange: "<= 20s",
+		},
+		{
+			title:         "value greater than the upper bound",
+			flag:          flags.Duration("long", "usage").WithMax(20 * time.Second),
+			value:         "21s",
+			expectedRange: "<= 20s",
+			expectedError: "21s is not an acceptable value for --long. The expected range is <= 20s.",
+		},
+		{
+			title:         "value equal to the exclusive upper bound",
+			flag:          flags.Duration("long", "usage").WithMax(20*time.Second, core.Exclusive),
+			value:         "20s",
+			expectedRange: "< 20s",
+			expectedError: "20s is not an acceptable value for --long. The expected range is < 20s.",
+		},
+		{
+			title:         "value within the closed range",
+			flag:          flags.Duration("long", "usage").WithBetween(10*time.Second, 20*time.Second),
+			value:         "15s",
+			expectedValue: 15 * time.Second,
+			expectedRange: "[10s, 20s]",
+		},
+		{
+			title:         "value equal to the bound of the open range",
+			flag:          flags.Duration("long", "usage").WithBetween(10*time.Second, 20*time.Second, core.Exclusive),
+			value:         "20s",
+			expectedRange: "(10s, 20s)",
+			expectedError: "20s is not an acceptable value for --long. The expected range is (10s, 20s).",
+		},
+		{
+			title:         "value within the half-open range",
+			flag:          flags.Duration("long", "usage").WithMin(10*time.Second).WithMax(20*time.Second, core.Exclusive),
+			value:         "10s",
+			expectedValue: 10 * time.Second,
+			expectedRange: "[10s, 20s)",
+		},
+		{
+			title:         "validation callback takes priority over the bounds",
+			flag:          flags.Duration("long", "usage").WithBetween(10*time.Second, 20*time.Second).WithValidationCallback(func(in time.Duration) error { return nil }),
+			value:         "30s",
+			expectedValue: 30 * time.Second,
+			expectedRange: "[10s, 20s]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
 func TestDurationFlag_ResetToDefault(t *testing.T) {
 	testCases := []struct {
 		title                   string
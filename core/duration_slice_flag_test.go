@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestDurationSlice(t *testing.T) {
@@ -573,6 +574,72 @@ func TestDurationSliceFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestDurationSliceFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.DurationSliceFlag
+		value         string
+		expectedValue []time.Duration
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.DurationSlice("long", "usage"),
+			value:         "9s,30s",
+			expectedValue: []time.Duration{9 * time.Second, 30 * time.Second},
+		},
+		{
+			title:         "all the items within the inclusive lower bound",
+			flag:          flags.DurationSlice("long", "usage").WithMin(10 * time.Second),
+			value:         "10s,30s",
+			expectedValue: []time.Duration{10 * time.Second, 30 * time.Second},
+			expectedRange: ">= 10s",
+		},
+		{
+			title:         "an item less than the lower bound",
+			flag:          flags.DurationSlice("long", "usage").WithMin(10 * time.Second),
+			value:         "10s,9s",
+			expectedValue: []time.Duration{},
+			expectedRange: ">= 10s",
+			expectedError: "9s is not an acceptable value for --long. The expected range is >= 10s.",
+		},
+		{
+			title:         "an item equal to the exclusive upper bound",
+			flag:          flags.DurationSlice("long", "usage").WithMax(20*time.Second, core.Exclusive),
+			value:         "15s,20s",
+			expectedValue: []time.Duration{},
+			expectedRange: "< 20s",
+			expectedError: "20s is not an acceptable value for --long. The expected range is < 20s.",
+		},
+		{
+			title:         "all the items within the closed range",
+			flag:          flags.DurationSlice("long", "usage").WithBetween(10*time.Second, 20*time.Second),
+			value:         "10s,15s,20s",
+			expectedValue: []time.Duration{10 * time.Second, 15 * time.Second, 20 * time.Second},
+			expectedRange: "[10s, 20s]",
+		},
+		{
+			title:         "an item out of the closed range",
+			flag:          flags.DurationSlice("long", "usage").WithBetween(10*time.Second, 20*time.Second),
+			value:         "15s,21s",
+			expectedValue: []time.Duration{},
+			expectedRange: "[10s, 20s]",
+			expectedError: "21s is not an acceptable value for --long. The expected range is [10s, 20s].",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
 func TestDurationSliceFlag_ResetToDefault(t *testing.T) {
 	empty := make([]time.Duration, 0)
 	testCases := []struct {
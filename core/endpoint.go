@@ -0,0 +1,193 @@
+package core
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// UnixScheme the scheme of the unix domain socket endpoints (i.e. unix:///var/run/app.sock).
+const UnixScheme = "unix"
+
+var (
+	errInvalidEndpoint = errors.New("invalid endpoint")
+	errMissingPort     = errors.New("missing port")
+)
+
+// Endpoint represents a network endpoint.
+//
+// A TCP endpoint is defined by its host and port (i.e. "localhost:8080" or "[::1]:8080"),
+// whereas a unix domain socket endpoint is defined by the path to the socket (i.e. "unix:///var/run/app.sock").
+type Endpoint struct {
+	// Host the host name or the IP address of a TCP endpoint. The host of a listen address can be empty (i.e. ":8080").
+	Host string
+	// Port the port number of a TCP endpoint.
+	Port uint16
+	// Path the path to the socket of a unix domain socket endpoint.
+	Path string
+}
+
+// ParseEndpoint creates a new Endpoint object.
+//
+// The input string must be in "host:port", "[ipv6]:port" or "unix:///path/to/socket" format.
+func ParseEndpoint(endpoint string) (Endpoint, error) {
+	return parseEndpoint(strings.TrimSpace(endpoint), "")
+}
+
+// Network returns the name of the network of the endpoint ("tcp" or "unix"), as expected by net.Dial and net.Listen.
+func (e Endpoint) Network() string {
+	if e.IsUnix() {
+		return UnixScheme
+	}
+	return "tcp"
+}
+
+// IsUnix returns true if the endpoint is a unix domain socket.
+func (e Endpoint) IsUnix() bool {
+	return e.Path != ""
+}
+
+// Address returns the address of the endpoint, as expected by net.Dial and net.Listen.
+//
+// The address of a TCP endpoint is in "host:port" format and the address of a unix domain socket is the path to the socket.
+func (e Endpoint) Address() string {
+	if e.IsUnix() {
+		return e.Path
+	}
+	return net.JoinHostPort(e.Host, strconv.Itoa(int(e.Port)))
+}
+
+// String returns the string representation of the endpoint (i.e. "localhost:8080", "[::1]:8080" or "unix:///var/run/app.sock").
+func (e Endpoint) String() string {
+	if e.IsUnix() {
+		return UnixScheme + "://" + e.Path
+	}
+	if e.Host == "" && e.Port == 0 {
+		return ""
+	}
+	return e.Address()
+}
+
+// parseEndpoint parses the value and injects the default port (if specified) into the TCP endpoints without a port.
+func parseEndpoint(value, defaultPort string) (Endpoint, error) {
+	if strings.HasPrefix(strings.ToLower(value), UnixScheme+":") {
+		path := value[len(UnixScheme)+1:]
+		if strings.HasPrefix(path, "//") {
+			path = path[2:]
+		}
+		if path == "" {
+			return Endpoint{}, errInvalidEndpoint
+		}
+		return Endpoint{Path: path}, nil
+	}
+
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		// The host may only be an IP address or a host name (i.e. "::1", "[::1]" or "localhost")
+		host = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+		if !isEndpointHost(host) || !hasValidBrackets(value, host) || strings.Count(value, "[") != strings.Count(value, "]") {
+			return Endpoint{}, errInvalidEndpoint
+		}
+		if defaultPort == "" {
+			return Endpoint{}, errMissingPort
+		}
+		port = defaultPort
+	}
+	if port == "" {
+		if defaultPort == "" {
+			return Endpoint{}, errMissingPort
+		}
+		port = defaultPort
+	}
+
+	if (host != "" && !isEndpointHost(host)) || !hasValidBrackets(value, host) {
+		return Endpoint{}, errInvalidEndpoint
+	}
+	p, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return Endpoint{}, errInvalidEndpoint
+	}
+	return Endpoint{Host: host, Port: uint16(p)}, nil
+}
+
+// isEndpointHost returns true if the host is an IP address (with an optional IPv6 zone) or a host name.
+func isEndpointHost(host string) bool {
+	if strings.Contains(host, ":") {
+		// IPv6 address (i.e. fe80::1%eth0)
+		if i := strings.LastIndex(host, "%"); i > 0 {
+			host = host[:i]
+		}
+		return net.ParseIP(host) != nil
+	}
+	return net.ParseIP(host) != nil || isHostname(host)
+}
+
+// hasValidBrackets returns false if the host of the value has been enclosed in brackets, but it is not an IPv6 address
+// (i.e. "[localhost]:8080").
+func hasValidBrackets(value, host string) bool {
+	return !strings.HasPrefix(value, "[") || strings.Contains(host, ":")
+}
+
+// endpointRules holds the declarative validators of the endpoint flags.
+type endpointRules struct {
+	defaultPort string
+	port        uintBounds
+	requireHost bool
+	allowUnix   bool
+}
+
+func (r *endpointRules) setDefaultPort(port uint16) {
+	r.defaultPort = strconv.Itoa(int(port))
+}
+
+// parse parses the value and injects the default port (if specified).
+//
+// The unix domain socket endpoints will be rejected if they have not been allowed.
+// The returned error must be translated to a user-facing error using parseErr(...).
+func (r *endpointRules) parse(value string) (Endpoint, error) {
+	e, err := parseEndpoint(value, r.defaultPort)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	if e.IsUnix() && !r.allowUnix {
+		return Endpoint{}, errInvalidEndpoint
+	}
+	return e, nil
+}
+
+// parseErr translates the parse errors into the user-facing error messages.
+func (r *endpointRules) parseErr(err error, input, long, short, flagType string) error {
+	if err == errMissingPort {
+		return internal.NewMessageErr(internal.MsgMissingPort, input, internal.GetPrintName(long, short))
+	}
+	return internal.InvalidValueErr(input, long, short, flagType)
+}
+
+// check validates the host and the port of the TCP endpoints.
+func (r *endpointRules) check(e Endpoint, input, long, short string) error {
+	name := internal.GetPrintName(long, short)
+	if e.IsUnix() {
+		return nil
+	}
+	if r.requireHost && e.Host == "" {
+		return internal.NewMessageErr(internal.MsgMissingHost, input, name)
+	}
+	if !r.port.contains(uint64(e.Port)) {
+		return internal.NewMessageErr(internal.MsgPortOutOfBounds, input, name, r.port.toRange(formatUInt))
+	}
+	return nil
+}
+
+func (r *endpointRules) constraints() []Constraint {
+	constraints := make([]Constraint, 0)
+	if port := r.port.toRange(formatUInt); port != nil {
+		constraints = append(constraints, NewConstraint(MsgPort, port))
+	}
+	if r.requireHost {
+		constraints = append(constraints, NewConstraint(MsgHostRequired))
+	}
+	return constraints
+}
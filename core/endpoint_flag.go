@@ -0,0 +1,396 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// EndpointFlag represents a network endpoint flag.
+//
+// The value of an endpoint flag can be specified in "host:port" or "[ipv6]:port" format (i.e. "localhost:8080" or "[::1]:8080").
+// Unix domain socket endpoints (i.e. "unix:///var/run/app.sock") are only acceptable if they have been allowed using AllowUnix().
+type EndpointFlag struct {
+	key                 *Key
+	defaultValue, value Endpoint
+	hasDefault          bool
+	ptr                 *Endpoint
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	validate            func(in Endpoint) error
+	validationList      map[string]interface{}
+	acceptableItems     []string
+	rules               endpointRules
+	validators          []Validator
+}
+
+// NewEndpoint creates a new network endpoint flag.
+func NewEndpoint(name, usage string) *EndpointFlag {
+	f := &EndpointFlag{
+		key:   &Key{},
+		long:  internal.SanitiseLongName(name),
+		usage: usage,
+		ptr:   new(Endpoint),
+	}
+	f.set(Endpoint{})
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --listen).
+func (f *EndpointFlag) LongName() string {
+	return f.long
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *EndpointFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *EndpointFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *EndpointFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -l).
+func (f *EndpointFlag) WithShort(short string) *EndpointFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *EndpointFlag) Required() *EndpointFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *EndpointFlag) Type() string {
+	return "endpoint"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -l).
+func (f *EndpointFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *EndpointFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *EndpointFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *EndpointFlag) Var() *Endpoint {
+	return f.ptr
+}
+
+// Get returns the current value of the flag.
+func (f *EndpointFlag) Get() Endpoint {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *EndpointFlag) WithKey(keyID string) *EndpointFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *EndpointFlag) WithDefault(defaultValue Endpoint) *EndpointFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *EndpointFlag) Hide() *EndpointFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *EndpointFlag) WithGroup(name string) *EndpointFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *EndpointFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *EndpointFlag) WithDescription(description string) *EndpointFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *EndpointFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *EndpointFlag) WithExamples(examples ...string) *EndpointFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *EndpointFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *EndpointFlag) MarkAsDeprecated(options ...DeprecationOption) *EndpointFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *EndpointFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// You can also define a list of acceptable values using WithValidRange(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *EndpointFlag) WithValidationCallback(validate func(in Endpoint) error) *EndpointFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *EndpointFlag) WithValidators(validators ...Validator) *EndpointFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *EndpointFlag) Constraints() []Constraint {
+	return append(f.rules.constraints(), describeValidators(f.validators)...)
+}
+
+// WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
+//
+// The set operation will fail if the flag value is not from the specified list.
+// You can also define a custom validation callback function using WithValidationCallback(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *EndpointFlag) WithValidRange(valid ...Endpoint) *EndpointFlag {
+	if len(valid) == 0 {
+		return f
+	}
+	f.validationList = make(map[string]interface{})
+	f.acceptableItems = make([]string, 0)
+	for _, e := range valid {
+		s := e.String()
+		if internal.IsEmpty(s) {
+			continue
+		}
+		if _, ok := f.validationList[s]; !ok {
+			f.validationList[s] = nil
+			f.acceptableItems = append(f.acceptableItems, s)
+		}
+	}
+	return f
+}
+
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *EndpointFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithDefaultPort sets the port which will be used if no port has been provided (i.e. "localhost" or "[::1]").
+func (f *EndpointFlag) WithDefaultPort(port uint16) *EndpointFlag {
+	f.rules.setDefaultPort(port)
+	return f
+}
+
+// WithPortRange sets the acceptable range of the port (inclusive).
+//
+// Remember that setting the port range will have no effect if a validation callback has been specified.
+func (f *EndpointFlag) WithPortRange(min, max uint16) *EndpointFlag {
+	f.rules.port.setMin(uint64(min), nil)
+	f.rules.port.setMax(uint64(max), nil)
+	return f
+}
+
+// RequireHost rejects the endpoints without a host (i.e. ":8080").
+//
+// Remember that requiring the host will have no effect if a validation callback has been specified.
+func (f *EndpointFlag) RequireHost() *EndpointFlag {
+	f.rules.requireHost = true
+	return f
+}
+
+// AllowUnix accepts the unix domain socket endpoints (i.e. "unix:///var/run/app.sock").
+func (f *EndpointFlag) AllowUnix() *EndpointFlag {
+	f.rules.allowUnix = true
+	return f
+}
+
+// Set sets the flag value.
+//
+// The value of an endpoint flag can be specified in "host:port" or "[ipv6]:port" format (i.e. "localhost:8080" or "[::1]:8080").
+// Unix domain socket endpoints (i.e. "unix:///var/run/app.sock") are only acceptable if they have been allowed using AllowUnix().
+func (f *EndpointFlag) Set(value string) error {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		f.set(Endpoint{})
+		f.isSet = true
+		return nil
+	}
+	endpoint, err := f.rules.parse(value)
+	if err != nil {
+		return f.rules.parseErr(err, value, f.long, f.short, f.Type())
+	}
+
+	if err := f.validateValue(endpoint, value); err != nil {
+		return err
+	}
+
+	f.set(endpoint)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *EndpointFlag) validateValue(v Endpoint, input string) error {
+	if f.validate != nil {
+		err := f.validate(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(v, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list and the declarative rules
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[v.String()]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil {
+		return f.rules.check(v, input, f.long, f.short)
+	}
+
+	return nil
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *EndpointFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	f.set(f.defaultValue)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The default value can be defined using WithDefault(...) method.
+func (f *EndpointFlag) Default() interface{} {
+	if !f.hasDefault {
+		return nil
+	}
+
+	return f.defaultValue
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *EndpointFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue, f.defaultValue.String())
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *EndpointFlag) Key() *Key {
+	return f.key
+}
+
+func (f *EndpointFlag) set(value Endpoint) {
+	f.value = value
+	*f.ptr = value
+}
@@ -0,0 +1,251 @@
+package core_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+func checkEndpoint(t *testing.T, expected string, actual core.Endpoint, actualVar *core.Endpoint) {
+	t.Helper()
+	if actual.String() != expected {
+		t.Errorf("Expected value: %s, Actual: %s", expected, actual)
+	}
+	if actualVar.String() != expected {
+		t.Errorf("Expected flag variable: %s, Actual: %s", expected, actualVar)
+	}
+}
+
+func TestEndpoint(t *testing.T) {
+	f := flags.Endpoint("LONG", "usage")
+	checkFlagInitialState(t, f, "endpoint", "usage", "long", "")
+	checkEndpoint(t, "", f.Get(), f.Var())
+}
+
+func TestEndpointFlag_WithDefault(t *testing.T) {
+	f := flags.Endpoint("long", "usage").WithDefault(core.Endpoint{Host: "::1", Port: 8080})
+	actual := core.FormatValue(f.Default())
+	expected := "[::1]:8080"
+	if actual != expected {
+		t.Errorf("Expected Default Value: %s, Actual: %s", expected, actual)
+	}
+	f.ResetToDefault()
+	checkEndpoint(t, expected, f.Get(), f.Var())
+	if f.IsSet() {
+		t.Error("IsSet(), Expected value: false, Actual: true")
+	}
+}
+
+func TestEndpointFlag_Set(t *testing.T) {
+	testCases := []struct {
+		title           string
+		flag            *core.EndpointFlag
+		value           string
+		expectedValue   string
+		expectedNetwork string
+		expectedError   string
+	}{
+		{
+			title:           "no value",
+			flag:            flags.Endpoint("long", "usage"),
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "value with white space",
+			flag:            flags.Endpoint("long", "usage"),
+			value:           "  localhost:8080  ",
+			expectedValue:   "localhost:8080",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "IPv6 address",
+			flag:            flags.Endpoint("long", "usage"),
+			value:           "[2001:db8::1]:443",
+			expectedValue:   "[2001:db8::1]:443",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:         "invalid value",
+			flag:          flags.Endpoint("long", "usage"),
+			value:         "localhost:http",
+			expectedError: "'localhost:http' is not a valid endpoint value for --long",
+		},
+		{
+			title:         "missing port",
+			flag:          flags.Endpoint("long", "usage").WithShort("l"),
+			value:         "localhost",
+			expectedError: "'localhost' is not an acceptable value for -l, --long. The port cannot be empty.",
+		},
+		{
+			title:           "default port injection",
+			flag:            flags.Endpoint("long", "usage").WithDefaultPort(9092),
+			value:           "localhost",
+			expectedValue:   "localhost:9092",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "default port injection with empty port",
+			flag:            flags.Endpoint("long", "usage").WithDefaultPort(9092),
+			value:           "localhost:",
+			expectedValue:   "localhost:9092",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "default port injection with IPv6 host",
+			flag:            flags.Endpoint("long", "usage").WithDefaultPort(9092),
+			value:           "[::1]",
+			expectedValue:   "[::1]:9092",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "default port injection with IPv6 host without brackets",
+			flag:            flags.Endpoint("long", "usage").WithDefaultPort(9092),
+			value:           "::1",
+			expectedValue:   "[::1]:9092",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "explicit port takes priority over the default port",
+			flag:            flags.Endpoint("long", "usage").WithDefaultPort(9092),
+			value:           "localhost:9093",
+			expectedValue:   "localhost:9093",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:           "port within the range",
+			flag:            flags.Endpoint("long", "usage").WithPortRange(1024, 65535),
+			value:           "localhost:1024",
+			expectedValue:   "localhost:1024",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:         "port out of the range",
+			flag:          flags.Endpoint("long", "usage").WithPortRange(1024, 65535),
+			value:         "localhost:80",
+			expectedError: "'localhost:80' is not an acceptable value for --long. The expected port range is [1024, 65535].",
+		},
+		{
+			title:           "empty host",
+			flag:            flags.Endpoint("long", "usage"),
+			value:           ":8080",
+			expectedValue:   ":8080",
+			expectedNetwork: "tcp",
+		},
+		{
+			title:         "missing host",
+			flag:          flags.Endpoint("long", "usage").RequireHost(),
+			value:         ":8080",
+			expectedError: "':8080' is not an acceptable value for --long. The host cannot be empty.",
+		},
+		{
+			title:         "unix domain socket is not allowed",
+			flag:          flags.Endpoint("long", "usage"),
+			value:         "unix:///var/run/app.sock",
+			expectedError: "'unix:///var/run/app.sock' is not a valid endpoint value for --long",
+		},
+		{
+			title:           "unix domain socket",
+			flag:            flags.Endpoint("long", "usage").AllowUnix().RequireHost().WithPortRange(1024, 65535),
+			value:           "unix:///var/run/app.sock",
+			expectedValue:   "unix:///var/run/app.sock",
+			expectedNetwork: "unix",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			if tc.expectedError == "" && !tc.flag.IsSet() {
+				t.Error("IsSet(), Expected value: true, Actual: false")
+			}
+			checkEndpoint(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if tc.expectedError == "" && tc.flag.Get().Network() != tc.expectedNetwork {
+				t.Errorf("Expected network: %s, Actual: %s", tc.expectedNetwork, tc.flag.Get().Network())
+			}
+		})
+	}
+}
+
+func TestEndpointFlag_Validation(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.EndpointFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title:         "value from the validation list",
+			flag:          flags.Endpoint("long", "usage").WithValidRange(core.Endpoint{Host: "a.com", Port: 80}, core.Endpoint{Host: "b.com", Port: 80}),
+			value:         "b.com:80",
+			expectedValue: "b.com:80",
+		},
+		{
+			title:         "value not from the validation list",
+			flag:          flags.Endpoint("long", "usage").WithValidRange(core.Endpoint{Host: "a.com", Port: 80}, core.Endpoint{Host: "b.com", Port: 80}),
+			value:         "c.com:80",
+			expectedError: "c.com:80 is not an acceptable value for --long. The expected values are a.com:80,b.com:80.",
+		},
+		{
+			title: "validation callback takes priority over the declarative rules",
+			flag: flags.Endpoint("long", "usage").WithPortRange(1024, 65535).RequireHost().WithValidationCallback(func(in core.Endpoint) error {
+				return nil
+			}),
+			value:         ":80",
+			expectedValue: ":80",
+		},
+		{
+			title: "validation callback error",
+			flag: flags.Endpoint("long", "usage").WithValidationCallback(func(in core.Endpoint) error {
+				return errors.New("validation callback failed")
+			}),
+			value:         "localhost:80",
+			expectedError: "validation callback failed",
+		},
+		{
+			title: "validation callback does not allow unix domain sockets",
+			flag: flags.Endpoint("long", "usage").WithValidationCallback(func(in core.Endpoint) error {
+				return nil
+			}),
+			value:         "unix:///var/run/app.sock",
+			expectedError: "'unix:///var/run/app.sock' is not a valid endpoint value for --long",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			checkEndpoint(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestEndpointFlag_Constraints(t *testing.T) {
+	f := flags.Endpoint("long", "usage").WithPortRange(1024, 65535).RequireHost()
+	constraints := make([]string, 0)
+	for _, c := range f.Constraints() {
+		constraints = append(constraints, c.String())
+	}
+	expected := "port: [1024, 65535], host required"
+	if actual := strings.Join(constraints, ", "); actual != expected {
+		t.Errorf("Expected Constraints: %s, Actual: %s", expected, actual)
+	}
+}
+
+func TestEndpointFlag_ValidateDefault(t *testing.T) {
+	f := flags.Endpoint("long", "usage").WithPortRange(1024, 65535).WithDefault(core.Endpoint{Host: "localhost", Port: 80})
+	expected := "'localhost:80' is not an acceptable value for --long. The expected port range is [1024, 65535]."
+	if err := f.ValidateDefault(); !test.ErrorContainsExact(err, expected) {
+		t.Errorf("Expected to receive '%s', but received %s", expected, err)
+	}
+}
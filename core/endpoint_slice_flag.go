@@ -0,0 +1,449 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// EndpointSliceFlag represents a network endpoint slice flag.
+//
+// The value of an endpoint slice flag can be specified using a comma (or any custom delimiter) separated string of
+// endpoints in "host:port" or "[ipv6]:port" format (i.e. "10.0.0.1:9092, [::1]:9092").
+// Unix domain socket endpoints (i.e. "unix:///var/run/app.sock") are only acceptable if they have been allowed using AllowUnix().
+type EndpointSliceFlag struct {
+	key                 *Key
+	defaultValue, value []Endpoint
+	hasDefault          bool
+	ptr                 *[]Endpoint
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	delimiter           string
+	validate            func(in Endpoint) error
+	validationList      map[string]interface{}
+	acceptableItems     []string
+	items               itemRules
+	rules               endpointRules
+	validators          []Validator
+}
+
+// NewEndpointSlice creates a new network endpoint slice flag.
+func NewEndpointSlice(name, usage string) *EndpointSliceFlag {
+	f := &EndpointSliceFlag{
+		key:       &Key{},
+		long:      internal.SanitiseLongName(name),
+		usage:     usage,
+		ptr:       new([]Endpoint),
+		delimiter: DefaultDelimiter,
+	}
+	f.set(nil)
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --brokers).
+func (f *EndpointSliceFlag) LongName() string {
+	return f.long
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *EndpointSliceFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *EndpointSliceFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -b).
+func (f *EndpointSliceFlag) WithShort(short string) *EndpointSliceFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *EndpointSliceFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *EndpointSliceFlag) Required() *EndpointSliceFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *EndpointSliceFlag) Type() string {
+	return "[]endpoint"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -b).
+func (f *EndpointSliceFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *EndpointSliceFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *EndpointSliceFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *EndpointSliceFlag) Var() *[]Endpoint {
+	return f.ptr
+}
+
+// Get returns the current value of the flag.
+func (f *EndpointSliceFlag) Get() []Endpoint {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *EndpointSliceFlag) WithKey(keyID string) *EndpointSliceFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *EndpointSliceFlag) WithDefault(defaultValue []Endpoint) *EndpointSliceFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *EndpointSliceFlag) Hide() *EndpointSliceFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *EndpointSliceFlag) WithGroup(name string) *EndpointSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *EndpointSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *EndpointSliceFlag) WithDescription(description string) *EndpointSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *EndpointSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *EndpointSliceFlag) WithExamples(examples ...string) *EndpointSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *EndpointSliceFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *EndpointSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *EndpointSliceFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *EndpointSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
+func (f *EndpointSliceFlag) WithDelimiter(delimiter string) *EndpointSliceFlag {
+	if len(delimiter) == 0 {
+		delimiter = DefaultDelimiter
+	}
+	f.delimiter = delimiter
+	return f
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// You can also define a list of acceptable values using WithValidRange(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *EndpointSliceFlag) WithValidationCallback(validate func(in Endpoint) error) *EndpointSliceFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *EndpointSliceFlag) WithValidators(validators ...Validator) *EndpointSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
+//
+// The set operation will fail if the flag value is not from the specified list.
+// You can also define a custom validation callback function using WithValidationCallback(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *EndpointSliceFlag) WithValidRange(valid ...Endpoint) *EndpointSliceFlag {
+	if len(valid) == 0 {
+		return f
+	}
+	f.validationList = make(map[string]interface{})
+	f.acceptableItems = make([]string, 0)
+	for _, e := range valid {
+		s := e.String()
+		if len(s) == 0 {
+			continue
+		}
+		if _, ok := f.validationList[s]; !ok {
+			f.acceptableItems = append(f.acceptableItems, s)
+			f.validationList[s] = nil
+		}
+	}
+	return f
+}
+
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *EndpointSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *EndpointSliceFlag) WithMinItems(min int) *EndpointSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *EndpointSliceFlag) WithMaxItems(max int) *EndpointSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *EndpointSliceFlag) Unique() *EndpointSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// WithDefaultPort sets the port which will be used if no port has been provided (i.e. "localhost" or "[::1]").
+func (f *EndpointSliceFlag) WithDefaultPort(port uint16) *EndpointSliceFlag {
+	f.rules.setDefaultPort(port)
+	return f
+}
+
+// WithPortRange sets the acceptable range of the port (inclusive).
+//
+// Remember that setting the port range will have no effect if a validation callback has been specified.
+func (f *EndpointSliceFlag) WithPortRange(min, max uint16) *EndpointSliceFlag {
+	f.rules.port.setMin(uint64(min), nil)
+	f.rules.port.setMax(uint64(max), nil)
+	return f
+}
+
+// RequireHost rejects the endpoints without a host (i.e. ":8080").
+//
+// Remember that requiring the host will have no effect if a validation callback has been specified.
+func (f *EndpointSliceFlag) RequireHost() *EndpointSliceFlag {
+	f.rules.requireHost = true
+	return f
+}
+
+// AllowUnix accepts the unix domain socket endpoints (i.e. "unix:///var/run/app.sock").
+func (f *EndpointSliceFlag) AllowUnix() *EndpointSliceFlag {
+	f.rules.allowUnix = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *EndpointSliceFlag) Constraints() []Constraint {
+	constraints := append(f.items.constraints(), f.rules.constraints()...)
+	return append(constraints, describeValidators(f.validators)...)
+}
+
+// Set sets the flag value.
+//
+// The value of an endpoint slice flag can be specified using a comma (or any custom delimiter) separated string of
+// endpoints in "host:port" or "[ipv6]:port" format (i.e. "10.0.0.1:9092, [::1]:9092").
+func (f *EndpointSliceFlag) Set(value string) error {
+	parts := strings.Split(strings.TrimSpace(value), f.delimiter)
+	list := make([]Endpoint, 0)
+	for _, v := range parts {
+		value = strings.TrimSpace(v)
+		if internal.IsEmpty(v) {
+			continue
+		}
+		endpoint, err := f.rules.parse(value)
+		if err != nil {
+			return f.rules.parseErr(err, value, f.long, f.short, f.Type())
+		}
+
+		if err := f.validateItem(endpoint, value); err != nil {
+			return err
+		}
+
+		list = append(list, endpoint)
+	}
+
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
+	f.set(list)
+	f.isSet = true
+	return nil
+}
+
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *EndpointSliceFlag) validateItem(item Endpoint, input string) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list and the declarative rules
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item.String()]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil {
+		return f.rules.check(item, input, f.long, f.short)
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *EndpointSliceFlag) validateItems(list []Endpoint) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return list[i].String()
+	})
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *EndpointSliceFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	f.set(f.defaultValue)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The default value can be defined using WithDefault(...) method.
+func (f *EndpointSliceFlag) Default() interface{} {
+	if !f.hasDefault {
+		return nil
+	}
+
+	return f.defaultValue
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *EndpointSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item, item.String()); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *EndpointSliceFlag) Key() *Key {
+	return f.key
+}
+
+func (f *EndpointSliceFlag) set(value []Endpoint) {
+	f.value = value
+	*f.ptr = value
+}
@@ -0,0 +1,148 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+func checkEndpointSlice(t *testing.T, expected []string, actual []core.Endpoint, actualVar *[]core.Endpoint) {
+	t.Helper()
+	toStrings := func(list []core.Endpoint) []string {
+		result := make([]string, len(list))
+		for i, e := range list {
+			result[i] = e.String()
+		}
+		return result
+	}
+	if a, e := strings.Join(toStrings(actual), ","), strings.Join(expected, ","); a != e {
+		t.Errorf("Expected value: %s, Actual: %s", e, a)
+	}
+	if a, e := strings.Join(toStrings(*actualVar), ","), strings.Join(expected, ","); a != e {
+		t.Errorf("Expected flag variable: %s, Actual: %s", e, a)
+	}
+}
+
+func TestEndpointSlice(t *testing.T) {
+	f := flags.EndpointSlice("LONG", "usage")
+	checkFlagInitialState(t, f, "[]endpoint", "usage", "long", "")
+	checkEndpointSlice(t, nil, f.Get(), f.Var())
+}
+
+func TestEndpointSliceFlag_Set(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.EndpointSliceFlag
+		value         string
+		expectedValue []string
+		expectedError string
+	}{
+		{
+			title: "empty value",
+			flag:  flags.EndpointSlice("long", "usage"),
+		},
+		{
+			title:         "comma separated value with white space",
+			flag:          flags.EndpointSlice("long", "usage"),
+			value:         " 10.0.0.1:9092 , [::1]:9092 ,",
+			expectedValue: []string{"10.0.0.1:9092", "[::1]:9092"},
+		},
+		{
+			title:         "custom delimiter",
+			flag:          flags.EndpointSlice("long", "usage").WithDelimiter("|"),
+			value:         "a.com:80|b.com:80",
+			expectedValue: []string{"a.com:80", "b.com:80"},
+		},
+		{
+			title:         "partially invalid value",
+			flag:          flags.EndpointSlice("long", "usage"),
+			value:         "a.com:80,a.com:http",
+			expectedError: "'a.com:http' is not a valid []endpoint value for --long",
+		},
+		{
+			title:         "missing port",
+			flag:          flags.EndpointSlice("long", "usage"),
+			value:         "a.com:80,b.com",
+			expectedError: "'b.com' is not an acceptable value for --long. The port cannot be empty.",
+		},
+		{
+			title:         "default port injection",
+			flag:          flags.EndpointSlice("long", "usage").WithDefaultPort(9092),
+			value:         "a.com,[::1],c.com:9093",
+			expectedValue: []string{"a.com:9092", "[::1]:9092", "c.com:9093"},
+		},
+		{
+			title:         "port out of the range",
+			flag:          flags.EndpointSlice("long", "usage").WithPortRange(1024, 65535),
+			value:         "a.com:8080,b.com:80",
+			expectedError: "'b.com:80' is not an acceptable value for --long. The expected port range is [1024, 65535].",
+		},
+		{
+			title:         "missing host",
+			flag:          flags.EndpointSlice("long", "usage").RequireHost(),
+			value:         "a.com:80,:80",
+			expectedError: "':80' is not an acceptable value for --long. The host cannot be empty.",
+		},
+		{
+			title:         "unix domain socket is not allowed",
+			flag:          flags.EndpointSlice("long", "usage"),
+			value:         "a.com:80,unix:///var/run/app.sock",
+			expectedError: "'unix:///var/run/app.sock' is not a valid []endpoint value for --long",
+		},
+		{
+			title:         "unix domain socket",
+			flag:          flags.EndpointSlice("long", "usage").AllowUnix(),
+			value:         "a.com:80,unix:///var/run/app.sock",
+			expectedValue: []string{"a.com:80", "unix:///var/run/app.sock"},
+		},
+		{
+			title:         "duplicate items",
+			flag:          flags.EndpointSlice("long", "usage").WithDefaultPort(80).Unique(),
+			value:         "a.com,a.com:80",
+			expectedError: "'a.com:80' has been provided more than once for --long. The items must be unique.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			if tc.expectedError == "" && !tc.flag.IsSet() {
+				t.Error("IsSet(), Expected value: true, Actual: false")
+			}
+			checkEndpointSlice(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestEndpointSliceFlag_Default(t *testing.T) {
+	f := flags.EndpointSlice("long", "usage").WithDefault([]core.Endpoint{
+		{Host: "a.com", Port: 80},
+		{Path: "/var/run/app.sock"},
+	})
+	actual := core.FormatValue(f.Default())
+	expected := "a.com:80,unix:///var/run/app.sock"
+	if actual != expected {
+		t.Errorf("Expected Default Value: %s, Actual: %s", expected, actual)
+	}
+
+	f.ResetToDefault()
+	checkEndpointSlice(t, []string{"a.com:80", "unix:///var/run/app.sock"}, f.Get(), f.Var())
+}
+
+func TestEndpointSliceFlag_Constraints(t *testing.T) {
+	f := flags.EndpointSlice("long", "usage").WithMaxItems(3).WithPortRange(1, 65535).RequireHost()
+	constraints := make([]string, 0)
+	for _, c := range f.Constraints() {
+		constraints = append(constraints, c.String())
+	}
+	expected := "items: <= 3, port: [1, 65535], host required"
+	if actual := strings.Join(constraints, ", "); actual != expected {
+		t.Errorf("Expected Constraints: %s, Actual: %s", expected, actual)
+	}
+}
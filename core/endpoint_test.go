@@ -0,0 +1,185 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	testCases := []struct {
+		title           string
+		value           string
+		expected        core.Endpoint
+		expectedNetwork string
+		expectedAddress string
+		expectedString  string
+		expectedErr     string
+	}{
+		{
+			title:       "empty value",
+			value:       "",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:           "host name and port",
+			value:           " localhost:8080 ",
+			expected:        core.Endpoint{Host: "localhost", Port: 8080},
+			expectedNetwork: "tcp",
+			expectedAddress: "localhost:8080",
+			expectedString:  "localhost:8080",
+		},
+		{
+			title:           "IPv4 address and port",
+			value:           "10.0.0.1:9092",
+			expected:        core.Endpoint{Host: "10.0.0.1", Port: 9092},
+			expectedNetwork: "tcp",
+			expectedAddress: "10.0.0.1:9092",
+			expectedString:  "10.0.0.1:9092",
+		},
+		{
+			title:           "IPv6 address and port",
+			value:           "[::1]:8080",
+			expected:        core.Endpoint{Host: "::1", Port: 8080},
+			expectedNetwork: "tcp",
+			expectedAddress: "[::1]:8080",
+			expectedString:  "[::1]:8080",
+		},
+		{
+			title:           "IPv6 address with zone and port",
+			value:           "[fe80::1%eth0]:8080",
+			expected:        core.Endpoint{Host: "fe80::1%eth0", Port: 8080},
+			expectedNetwork: "tcp",
+			expectedAddress: "[fe80::1%eth0]:8080",
+			expectedString:  "[fe80::1%eth0]:8080",
+		},
+		{
+			title:           "listen address without host",
+			value:           ":8080",
+			expected:        core.Endpoint{Port: 8080},
+			expectedNetwork: "tcp",
+			expectedAddress: ":8080",
+			expectedString:  ":8080",
+		},
+		{
+			title:           "zero port",
+			value:           "localhost:0",
+			expected:        core.Endpoint{Host: "localhost"},
+			expectedNetwork: "tcp",
+			expectedAddress: "localhost:0",
+			expectedString:  "localhost:0",
+		},
+		{
+			title:           "unix domain socket",
+			value:           "unix:///var/run/app.sock",
+			expected:        core.Endpoint{Path: "/var/run/app.sock"},
+			expectedNetwork: "unix",
+			expectedAddress: "/var/run/app.sock",
+			expectedString:  "unix:///var/run/app.sock",
+		},
+		{
+			title:           "relative unix domain socket",
+			value:           "UNIX:app.sock",
+			expected:        core.Endpoint{Path: "app.sock"},
+			expectedNetwork: "unix",
+			expectedAddress: "app.sock",
+			expectedString:  "unix://app.sock",
+		},
+		{
+			title:       "unix domain socket without path",
+			value:       "unix://",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "missing port",
+			value:       "localhost",
+			expectedErr: "missing port",
+		},
+		{
+			title:       "empty port",
+			value:       "localhost:",
+			expectedErr: "missing port",
+		},
+		{
+			title:       "IPv6 address without brackets",
+			value:       "::1",
+			expectedErr: "missing port",
+		},
+		{
+			title:       "IPv6 address with unbalanced brackets",
+			value:       "[::1",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "host name in brackets",
+			value:       "[localhost]:80",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "IPv4 address in brackets",
+			value:       "[127.0.0.1]:80",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "host name in brackets without port",
+			value:       "[localhost]",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "empty brackets",
+			value:       "[]:80",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "port out of range",
+			value:       "localhost:65536",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "named port",
+			value:       "localhost:http",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "invalid host",
+			value:       "local host:8080",
+			expectedErr: "invalid endpoint",
+		},
+		{
+			title:       "too many colons",
+			value:       "a:b:8080",
+			expectedErr: "invalid endpoint",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual, err := core.ParseEndpoint(tc.value)
+			if !test.ErrorContainsExact(err, tc.expectedErr) {
+				t.Errorf("Expected '%s', but received %v", tc.expectedErr, err)
+			}
+			if actual != tc.expected {
+				t.Errorf("Expected %+v, Actual: %+v", tc.expected, actual)
+			}
+			if err != nil {
+				return
+			}
+			if n := actual.Network(); n != tc.expectedNetwork {
+				t.Errorf("Expected network: %s, Actual: %s", tc.expectedNetwork, n)
+			}
+			if a := actual.Address(); a != tc.expectedAddress {
+				t.Errorf("Expected address: %s, Actual: %s", tc.expectedAddress, a)
+			}
+			if s := actual.String(); s != tc.expectedString {
+				t.Errorf("Expected string: %s, Actual: %s", tc.expectedString, s)
+			}
+		})
+	}
+}
+
+func TestEndpoint_String(t *testing.T) {
+	if actual := (core.Endpoint{}).String(); actual != "" {
+		t.Errorf("Expected the string representation of an empty endpoint to be empty, Actual: %s", actual)
+	}
+}
@@ -6,6 +6,8 @@ import "github.com/xitonix/flags/internal"
 type ErrInvalidFlag struct {
 	long, short, key string
 	msg              string
+	id               string
+	args             []interface{}
 }
 
 // NewInvalidFlagErr creates a new instance of ErrInvalidFlag.
@@ -18,6 +20,20 @@ func NewInvalidFlagErr(long, short, key, msg string) *ErrInvalidFlag {
 	}
 }
 
+// NewInvalidFlagMessageErr creates a new instance of ErrInvalidFlag with a translatable message.
+//
+// The message will be rendered in English, unless it gets translated by calling Localise.
+func NewInvalidFlagMessageErr(long, short, key, id string, args ...interface{}) *ErrInvalidFlag {
+	return &ErrInvalidFlag{
+		long:  long,
+		short: short,
+		key:   key,
+		msg:   internal.EnglishMessage(id, args...),
+		id:    id,
+		args:  args,
+	}
+}
+
 // Error returns the string representation of an ErrInvalidFlag.
 func (e *ErrInvalidFlag) Error() string {
 	var str string
@@ -36,3 +52,18 @@ func (e *ErrInvalidFlag) Error() string {
 	}
 	return str + " " + e.msg
 }
+
+// Localise translates the error message using the specified message function (i.e. catalogue.Message).
+//
+// The messages of the errors created by NewInvalidFlagErr will not be translated.
+func (e *ErrInvalidFlag) Localise(message func(id string, args ...interface{}) string) {
+	if internal.IsEmpty(e.id) {
+		return
+	}
+	for _, arg := range e.args {
+		if l, ok := arg.(LocalisableError); ok {
+			l.Localise(message)
+		}
+	}
+	e.msg = message(e.id, e.args...)
+}
@@ -69,3 +69,21 @@ func TestErrInvalidFlag_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestErrInvalidFlag_Localise(t *testing.T) {
+	err := core.NewInvalidFlagMessageErr("--old", "-o", "", core.MsgMissingReplacement, "new")
+	if actual, expected := err.Error(), "--old, -o replacement flag --new does not exist"; actual != expected {
+		t.Errorf("Expected error message: %s, Actual: %s", expected, actual)
+	}
+	catalogue := core.MessageMap{core.MsgMissingReplacement: "Ersatz-Flag --%s existiert nicht"}
+	err.Localise(catalogue.Message)
+	if actual, expected := err.Error(), "--old, -o Ersatz-Flag --new existiert nicht"; actual != expected {
+		t.Errorf("Expected error message: %s, Actual: %s", expected, actual)
+	}
+
+	plain := core.NewInvalidFlagErr("--old", "", "", "error message")
+	plain.Localise(catalogue.Message)
+	if actual, expected := plain.Error(), "--old error message"; actual != expected {
+		t.Errorf("Expected error message: %s, Actual: %s", expected, actual)
+	}
+}
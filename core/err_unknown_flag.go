@@ -1,18 +1,27 @@
 package core
 
+import "github.com/xitonix/flags/internal"
+
 // ErrUnknownFlag occurs when an undefined flag has been passed to the tool as a command line argument.
 type ErrUnknownFlag struct {
 	name string
+	text string
 }
 
 // NewUnknownFlagErr creates a new instance of ErrUnknownFlag
 func NewUnknownFlagErr(name string) *ErrUnknownFlag {
 	return &ErrUnknownFlag{
 		name: name,
+		text: internal.EnglishMessage(MsgUnknownFlag, name),
 	}
 }
 
 // Error returns the string representation of an ErrUnknownFlag.
 func (e *ErrUnknownFlag) Error() string {
-	return e.name + " is an unknown flag"
+	return e.text
+}
+
+// Localise translates the error message using the specified message function (i.e. catalogue.Message).
+func (e *ErrUnknownFlag) Localise(message func(id string, args ...interface{}) string) {
+	e.text = message(MsgUnknownFlag, e.name)
 }
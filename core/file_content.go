@@ -0,0 +1,110 @@
+package core
+
+import (
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+const (
+	// DefaultMaxFileSize the default maximum size of the files (or stdin) from which the flag values can be read.
+	DefaultMaxFileSize = 1 * MiB
+
+	// FilePrefix the prefix of the values which must be read from a file (i.e. --cert @/path/to/cert.pem).
+	//
+	// The values starting with two prefixes will be passed to the flag as they are, without the first prefix
+	// (i.e. --name @@admin sets the value of the flag to @admin).
+	FilePrefix = "@"
+
+	// StdinValue the value which must be replaced with the content of the standard input (i.e. --payload -).
+	StdinValue = "-"
+)
+
+// FileContent holds the settings of the flags whose values can be read from a file or the standard input.
+type FileContent struct {
+	// MaxSize is the maximum number of bytes which can be read from the file. Zero disables the limit.
+	MaxSize uint64
+	// Trim is true if the leading and trailing white spaces (i.e. the trailing new line) must be removed from the content.
+	Trim bool
+}
+
+// FileContentOption represents a file content option function.
+type FileContentOption func(c *FileContent)
+
+// WithMaxFileSize sets the maximum number of bytes which can be read from the file (Default: core.DefaultMaxFileSize).
+//
+// Setting the size to zero disables the limit.
+func WithMaxFileSize(size uint64) FileContentOption {
+	return func(c *FileContent) {
+		c.MaxSize = size
+	}
+}
+
+// TrimFileContent removes the leading and trailing white spaces (i.e. the trailing new line) from the content of the file.
+func TrimFileContent() FileContentOption {
+	return func(c *FileContent) {
+		c.Trim = true
+	}
+}
+
+// NewFileContent creates new file content settings.
+func NewFileContent(options ...FileContentOption) *FileContent {
+	c := &FileContent{
+		MaxSize: DefaultMaxFileSize,
+	}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Resolve returns the value which must be passed to the flag's Set method.
+//
+// The content of the file will be returned if the value starts with core.FilePrefix (i.e. @/path/to/cert.pem), and
+// the content of stdin will be returned if the value is core.StdinValue. Any other values will be returned as they are.
+// The long and the short names of the flag will be used in the error messages.
+func (c *FileContent) Resolve(value string, stdin io.Reader, long, short string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, FilePrefix+FilePrefix):
+		return value[len(FilePrefix):], nil
+	case strings.HasPrefix(value, FilePrefix):
+		path := value[len(FilePrefix):]
+		file, err := os.Open(path)
+		if err != nil {
+			// The path is already included in the message.
+			if pe, ok := err.(*os.PathError); ok {
+				err = pe.Err
+			}
+			return "", internal.NewMessageErr(internal.MsgFileRead, internal.GetPrintName(long, short), path, err)
+		}
+		defer file.Close()
+		return c.read(file, path, long, short)
+	case value == StdinValue:
+		return c.read(stdin, "stdin", long, short)
+	default:
+		return value, nil
+	}
+}
+
+func (c *FileContent) read(r io.Reader, source, long, short string) (string, error) {
+	// The sizes which cannot be represented by int64 are not going to be reached anyway.
+	if c.MaxSize > 0 && c.MaxSize < math.MaxInt64 {
+		r = io.LimitReader(r, int64(c.MaxSize)+1)
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", internal.NewMessageErr(internal.MsgFileRead, internal.GetPrintName(long, short), source, err)
+	}
+	if c.MaxSize > 0 && uint64(len(content)) > c.MaxSize {
+		return "", internal.NewMessageErr(internal.MsgFileTooLarge, source, internal.GetPrintName(long, short), ByteSize(c.MaxSize))
+	}
+	value := string(content)
+	if c.Trim {
+		value = strings.TrimSpace(value)
+	}
+	return value, nil
+}
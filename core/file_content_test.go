@@ -0,0 +1,137 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+func TestFileContent_Resolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "flags-file-content-test-")
+	if err != nil {
+		t.Fatalf("Failed to create the temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cert.pem")
+	if err := ioutil.WriteFile(path, []byte("  certificate\n"), 0600); err != nil {
+		t.Fatalf("Failed to create the temporary file: %s", err)
+	}
+	missing := filepath.Join(dir, "missing.pem")
+
+	testCases := []struct {
+		title         string
+		value         string
+		stdin         string
+		options       []core.FileContentOption
+		expectedValue string
+		expectedErr   string
+	}{
+		{
+			title:         "literal value",
+			value:         "certificate",
+			expectedValue: "certificate",
+		},
+		{
+			title:         "empty value",
+			value:         "",
+			expectedValue: "",
+		},
+		{
+			title:         "escaped prefix",
+			value:         "@@admin",
+			expectedValue: "@admin",
+		},
+		{
+			title:         "file content",
+			value:         "@" + path,
+			expectedValue: "  certificate\n",
+		},
+		{
+			title:         "trimmed file content",
+			value:         "@" + path,
+			options:       []core.FileContentOption{core.TrimFileContent()},
+			expectedValue: "certificate",
+		},
+		{
+			title:       "missing file",
+			value:       "@" + missing,
+			expectedErr: "The value of -c, --cert cannot be read from " + missing + ": no such file or directory.",
+		},
+		{
+			title:         "stdin content",
+			value:         "-",
+			stdin:         "payload\n",
+			expectedValue: "payload\n",
+		},
+		{
+			title:         "trimmed stdin content",
+			value:         "-",
+			stdin:         "payload\n",
+			options:       []core.FileContentOption{core.TrimFileContent()},
+			expectedValue: "payload",
+		},
+		{
+			title:         "empty stdin",
+			value:         "-",
+			expectedValue: "",
+		},
+		{
+			title:         "file content within the size limit",
+			value:         "@" + path,
+			options:       []core.FileContentOption{core.WithMaxFileSize(14)},
+			expectedValue: "  certificate\n",
+		},
+		{
+			title:       "file content exceeding the size limit",
+			value:       "@" + path,
+			options:     []core.FileContentOption{core.WithMaxFileSize(13)},
+			expectedErr: path + " is too large to be used as the value of -c, --cert. The maximum size is 13B.",
+		},
+		{
+			title:       "stdin content exceeding the size limit",
+			value:       "-",
+			stdin:       "payload",
+			options:     []core.FileContentOption{core.WithMaxFileSize(4)},
+			expectedErr: "stdin is too large to be used as the value of -c, --cert. The maximum size is 4B.",
+		},
+		{
+			title:         "size limit beyond the range of int64",
+			value:         "-",
+			stdin:         "payload",
+			options:       []core.FileContentOption{core.WithMaxFileSize(math.MaxUint64)},
+			expectedValue: "payload",
+		},
+		{
+			title:         "zero size limit",
+			value:         "-",
+			stdin:         strings.Repeat("x", int(core.DefaultMaxFileSize)+1),
+			options:       []core.FileContentOption{core.WithMaxFileSize(0)},
+			expectedValue: strings.Repeat("x", int(core.DefaultMaxFileSize)+1),
+		},
+		{
+			title:       "default size limit",
+			value:       "-",
+			stdin:       strings.Repeat("x", int(core.DefaultMaxFileSize)+1),
+			expectedErr: "stdin is too large to be used as the value of -c, --cert. The maximum size is 1MiB.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			fc := core.NewFileContent(tc.options...)
+			actual, err := fc.Resolve(tc.value, strings.NewReader(tc.stdin), "cert", "c")
+			if !test.ErrorContainsExact(err, tc.expectedErr) {
+				t.Errorf("Expected '%s', but received %v", tc.expectedErr, err)
+			}
+			if actual != tc.expectedValue {
+				t.Errorf("Expected value: %q, Actual: %q", tc.expectedValue, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package core_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+// createTempDir creates a temporary directory with a config.yaml file and a sub directory in it.
+func createTempDir(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "flags-path-test-")
+	if err != nil {
+		t.Fatalf("Failed to create the temporary directory: %s", err)
+	}
+	dir, _ = filepath.EvalSymlinks(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.yaml"), []byte("key: value"), 0600); err != nil {
+		t.Fatalf("Failed to create the temporary file: %s", err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0700); err != nil {
+		t.Fatalf("Failed to create the temporary sub directory: %s", err)
+	}
+	return dir
+}
+
+func TestFilePath(t *testing.T) {
+	f := flags.FilePath("LONG", "usage")
+	checkFlagInitialState(t, f, "file", "usage", "long", "")
+	checkFlagValues(t, "", f.Get(), f.Var())
+	if hint := f.ValueHint(); hint != core.FileHint {
+		t.Errorf("Expected Value Hint: %v, Actual: %v", core.FileHint, hint)
+	}
+}
+
+func TestFilePathFlag_Set(t *testing.T) {
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	home, _ := os.UserHomeDir()
+	_ = os.Setenv("FLAGS_TEST_DIR", dir)
+	defer os.Unsetenv("FLAGS_TEST_DIR")
+
+	testCases := []struct {
+		title         string
+		flag          *core.FilePathFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title: "empty value",
+			flag:  flags.FilePath("long", "usage"),
+		},
+		{
+			title:         "absolute path",
+			flag:          flags.FilePath("long", "usage"),
+			value:         "  " + filepath.Join(dir, "config.yaml") + "  ",
+			expectedValue: filepath.Join(dir, "config.yaml"),
+		},
+		{
+			title:         "relative path",
+			flag:          flags.FilePath("long", "usage"),
+			value:         "config.yaml",
+			expectedValue: filepath.Join(wd, "config.yaml"),
+		},
+		{
+			title:         "path will be cleaned",
+			flag:          flags.FilePath("long", "usage"),
+			value:         filepath.Join(dir, "sub", "..", "config.yaml"),
+			expectedValue: filepath.Join(dir, "config.yaml"),
+		},
+		{
+			title:         "home directory without expansion",
+			flag:          flags.FilePath("long", "usage"),
+			value:         "~/config.yaml",
+			expectedValue: filepath.Join(wd, "~", "config.yaml"),
+		},
+		{
+			title:         "home directory expansion",
+			flag:          flags.FilePath("long", "usage").ExpandHome(),
+			value:         "~/config.yaml",
+			expectedValue: filepath.Join(home, "config.yaml"),
+		},
+		{
+			title:         "environment variables without expansion",
+			flag:          flags.FilePath("long", "usage"),
+			value:         "$FLAGS_TEST_DIR/config.yaml",
+			expectedValue: filepath.Join(wd, "$FLAGS_TEST_DIR", "config.yaml"),
+		},
+		{
+			title:         "environment variables expansion",
+			flag:          flags.FilePath("long", "usage").ExpandEnv(),
+			value:         "${FLAGS_TEST_DIR}/config.yaml",
+			expectedValue: filepath.Join(dir, "config.yaml"),
+		},
+		{
+			title:         "existing file",
+			flag:          flags.FilePath("long", "usage").MustExist().Readable().Writable(),
+			value:         filepath.Join(dir, "config.yaml"),
+			expectedValue: filepath.Join(dir, "config.yaml"),
+		},
+		{
+			title:         "missing file",
+			flag:          flags.FilePath("long", "usage").WithShort("c").MustExist(),
+			value:         "missing.yaml",
+			expectedError: "'missing.yaml' is not an acceptable value for -c, --long. The path does not exist.",
+		},
+		{
+			title:         "missing file must be readable",
+			flag:          flags.FilePath("long", "usage").Readable(),
+			value:         "missing.yaml",
+			expectedError: "'missing.yaml' is not an acceptable value for --long. The path does not exist.",
+		},
+		{
+			title:         "missing file in a writable directory",
+			flag:          flags.FilePath("long", "usage").MustNotExist().Writable(),
+			value:         filepath.Join(dir, "new.yaml"),
+			expectedValue: filepath.Join(dir, "new.yaml"),
+		},
+		{
+			title:         "missing file in a missing directory",
+			flag:          flags.FilePath("long", "usage").Writable(),
+			value:         filepath.Join(dir, "missing", "new.yaml"),
+			expectedError: "is not an acceptable value for --long. The path is not writable.",
+		},
+		{
+			title:         "existing file must not exist",
+			flag:          flags.FilePath("long", "usage").MustNotExist(),
+			value:         filepath.Join(dir, "config.yaml"),
+			expectedError: "is not an acceptable value for --long. The path already exists.",
+		},
+		{
+			title:         "directory",
+			flag:          flags.FilePath("long", "usage"),
+			value:         filepath.Join(dir, "sub"),
+			expectedError: "is not an acceptable value for --long. The path is a directory.",
+		},
+		{
+			title:         "acceptable extension",
+			flag:          flags.FilePath("long", "usage").WithExtensions(".YAML", "json"),
+			value:         filepath.Join(dir, "config.yaml"),
+			expectedValue: filepath.Join(dir, "config.yaml"),
+		},
+		{
+			title:         "unacceptable extension",
+			flag:          flags.FilePath("long", "usage").WithExtensions(".yaml", "json"),
+			value:         "config.toml",
+			expectedError: "'config.toml' is not an acceptable value for --long. The expected extensions are .yaml, .json.",
+		},
+		{
+			title: "validation callback takes priority over the declarative rules",
+			flag: flags.FilePath("long", "usage").MustExist().WithValidationCallback(func(in string) error {
+				return nil
+			}),
+			value:         filepath.Join(dir, "missing.yaml"),
+			expectedValue: filepath.Join(dir, "missing.yaml"),
+		},
+		{
+			title: "validation callback receives the absolute path",
+			flag: flags.FilePath("long", "usage").WithValidationCallback(func(in string) error {
+				if !filepath.IsAbs(in) {
+					return errors.New("not absolute")
+				}
+				return errors.New("absolute")
+			}),
+			value:         "config.yaml",
+			expectedError: "absolute",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestFilePathFlag_Permissions(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("The permission checks are not effective for the root user")
+	}
+	dir := createTempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	if err := os.Chmod(path, 0); err != nil {
+		t.Fatalf("Failed to change the permissions: %s", err)
+	}
+
+	err := flags.FilePath("long", "usage").Readable().Set(path)
+	if !test.ErrorContains(err, "The path is not readable.") {
+		t.Errorf("Expected a not readable error, Actual: %v", err)
+	}
+	err = flags.FilePath("long", "usage").Writable().Set(path)
+	if !test.ErrorContains(err, "The path is not writable.") {
+		t.Errorf("Expected a not writable error, Actual: %v", err)
+	}
+}
+
+func TestFilePathFlag_Default(t *testing.T) {
+	home, _ := os.UserHomeDir()
+	f := flags.FilePath("long", "usage").ExpandHome().MustExist().WithDefault("~/missing.yaml")
+	if actual := f.Default(); actual != "~/missing.yaml" {
+		t.Errorf("Expected Default Value: ~/missing.yaml, Actual: %v", actual)
+	}
+	if err := f.ValidateDefault(); err != nil {
+		t.Errorf("Expected the file system checks to be skipped for the default value, Actual: %s", err)
+	}
+	f.ResetToDefault()
+	checkFlagValues(t, filepath.Join(home, "missing.yaml"), f.Get(), f.Var())
+
+	f = flags.FilePath("long", "usage").WithExtensions(".json").WithDefault("config.yaml")
+	expected := "'config.yaml' is not an acceptable value for --long. The expected extensions are .json."
+	if err := f.ValidateDefault(); !test.ErrorContainsExact(err, expected) {
+		t.Errorf("Expected to receive '%s', but received %v", expected, err)
+	}
+}
+
+func TestFilePathFlag_Constraints(t *testing.T) {
+	f := flags.FilePath("long", "usage").MustExist().Readable().Writable().WithExtensions(".yaml", ".json")
+	constraints := make([]string, 0)
+	for _, c := range f.Constraints() {
+		constraints = append(constraints, c.String())
+	}
+	expected := "must exist, readable, writable, extensions: .yaml, .json"
+	if actual := strings.Join(constraints, ", "); actual != expected {
+		t.Errorf("Expected Constraints: %s, Actual: %s", expected, actual)
+	}
+}
@@ -0,0 +1,59 @@
+package core
+
+import "strings"
+
+// DescriptionProvider is the interface for the flags which provide extended documentation.
+//
+// All the built-in flag types implement this interface.
+type DescriptionProvider interface {
+	// Description returns the long description of the flag.
+	Description() string
+	// Examples returns the usage examples of the flag.
+	Examples() []string
+}
+
+// FlagDetails holds the details which will be printed on the help page of a single flag (i.e. --help=port).
+type FlagDetails struct {
+	// Flag is the flag itself.
+	Flag Flag
+	// Value is the string representation of the value to which the flag resolves, given the current sources.
+	Value string
+	// Provenance holds the details of where the resolved value came from.
+	Provenance *Provenance
+}
+
+// FlagDetailsFormatter is an optional interface for the help formatters which can render the help page of a single flag.
+//
+// The bucket falls back to the Format method of the help formatter if the formatter does not implement this interface.
+type FlagDetailsFormatter interface {
+	// FormatDetails returns the help page of the flag.
+	FormatDetails(details *FlagDetails, deprecationMark, defaultValueFormatString, requiredMark string) string
+}
+
+// HiddenFlagRevealer is an optional interface for the help formatters which can render the hidden flags.
+//
+// The bucket reveals the hidden flags if the help output has been requested using --help-all.
+type HiddenFlagRevealer interface {
+	// RevealHidden sets whether the hidden flags must be rendered.
+	RevealHidden(reveal bool)
+}
+
+// DescriptionOf returns the long description of the flag.
+//
+// The method returns an empty string if the flag does not implement DescriptionProvider interface.
+func DescriptionOf(f Flag) string {
+	if p, ok := f.(DescriptionProvider); ok {
+		return strings.TrimSpace(p.Description())
+	}
+	return ""
+}
+
+// ExamplesOf returns the usage examples of the flag.
+//
+// The method returns nil if the flag does not implement DescriptionProvider interface.
+func ExamplesOf(f Flag) []string {
+	if p, ok := f.(DescriptionProvider); ok {
+		return p.Examples()
+	}
+	return nil
+}
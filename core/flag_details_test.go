@@ -0,0 +1,52 @@
+package core_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func TestDescriptionOf(t *testing.T) {
+	testCases := []struct {
+		title            string
+		flag             core.Flag
+		expected         string
+		expectedExamples []string
+	}{
+		{
+			title: "flag without description",
+			flag:  core.NewString("long", "usage"),
+		},
+		{
+			title:            "string flag",
+			flag:             core.NewString("long", "usage").WithDescription("Description").WithExamples("tool --long value"),
+			expected:         "Description",
+			expectedExamples: []string{"tool --long value"},
+		},
+		{
+			title:            "duration slice flag with white spaces",
+			flag:             core.NewDurationSlice("long", "usage").WithDescription("  Description\n").WithExamples("a", "b"),
+			expected:         "Description",
+			expectedExamples: []string{"a", "b"},
+		},
+		{
+			title: "flag without description provider",
+			flag:  mocks.NewFlag("long", "l"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := core.DescriptionOf(tc.flag)
+			if actual != tc.expected {
+				t.Errorf("Expected description: '%s', Actual: '%s'", tc.expected, actual)
+			}
+			examples := core.ExamplesOf(tc.flag)
+			if !reflect.DeepEqual(examples, tc.expectedExamples) {
+				t.Errorf("Expected examples: %v, Actual: %v", tc.expectedExamples, examples)
+			}
+		})
+	}
+}
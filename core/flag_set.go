@@ -0,0 +1,17 @@
+package core
+
+// FlagSet provides read access to the resolved flags of a bucket.
+type FlagSet interface {
+	// Flags returns all the flags within the set.
+	Flags() []Flag
+	// Lookup returns the flag with the specified long name, or nil if no such flag has been registered.
+	Lookup(longName string) Flag
+	// Provenance returns the details of where the value of the specified flag came from.
+	Provenance(longName string) *Provenance
+}
+
+// CrossFlagValidator defines a validation function which will be called once all the flag values have been resolved.
+//
+// Unlike the per flag validators, a cross flag validator can check the relationship between different flags
+// (i.e. --tls-key must be provided whenever --tls-cert has been set). The set is the bucket which is being parsed.
+type CrossFlagValidator func(set FlagSet) error
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestFloat32(t *testing.T) {
@@ -500,6 +501,104 @@ func TestFloat32Flag_Validation(t *testing.T) {
 	}
 }
 
+func TestFloat32Flag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.Float32Flag
+		value         string
+		expectedValue float32
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.Float32("long", "usage"),
+			value:         "0.25",
+			expectedValue: 0.25,
+		},
+		{
+			title:         "value equal to the inclusive lower bound",
+			flag:          flags.Float32("long", "usage").WithMin(0.5),
+			value:         "0.5",
+			expectedValue: 0.5,
+			expectedRange: ">= 0.5",
+		},
+		{
+			title:         "value less than the lower bound",
+			flag:          flags.Float32("long", "usage").WithMin(0.5),
+			value:         "0.25",
+			expectedRange: ">= 0.5",
+			expectedError: "0.25 is not an acceptable value for --long. The expected range is >= 0.5.",
+		},
+		{
+			title:         "value equal to the exclusive lower bound",
+			flag:          flags.Float32("long", "usage").WithMin(0.5, core.Exclusive),
+			value:         "0.5",
+			expectedRange: "> 0.5",
+			expectedError: "0.5 is not an acceptable value for --long. The expected range is > 0.5.",
+		},
+		{
+			title:         "value equal to the inclusive upper bound",
+			flag:          flags.Float32("long", "usage").WithMax(1.5),
+			value:         "1.5",
+			expectedValue: 1.5,
+			expectedRange: "<= 1.5",
+		},
+		{
+			title:         "value greater than the upper bound",
+			flag:          flags.Float32("long", "usage").WithMax(1.5),
+			value:         "1.75",
+			expectedRange: "<= 1.5",
+			expectedError: "1.75 is not an acceptable value for --long. The expected range is <= 1.5.",
+		},
+		{
+			title:         "value equal to the exclusive upper bound",
+			flag:          flags.Float32("long", "usage").WithMax(1.5, core.Exclusive),
+			value:         "1.5",
+			expectedRange: "< 1.5",
+			expectedError: "1.5 is not an acceptable value for --long. The expected range is < 1.5.",
+		},
+		{
+			title:         "value within the closed range",
+			flag:          flags.Float32("long", "usage").WithBetween(0.5, 1.5),
+			value:         "1",
+			expectedValue: 1,
+			expectedRange: "[0.5, 1.5]",
+		},
+		{
+			title:         "value equal to the bound of the open range",
+			flag:          flags.Float32("long", "usage").WithBetween(0.5, 1.5, core.Exclusive),
+			value:         "1.5",
+			expectedRange: "(0.5, 1.5)",
+			expectedError: "1.5 is not an acceptable value for --long. The expected range is (0.5, 1.5).",
+		},
+		{
+			title:         "value within the half-open range",
+			flag:          flags.Float32("long", "usage").WithMin(0.5).WithMax(1.5, core.Exclusive),
+			value:         "0.5",
+			expectedValue: 0.5,
+			expectedRange: "[0.5, 1.5)",
+		},
+		{
+			title:         "validation callback takes priority over the bounds",
+			flag:          flags.Float32("long", "usage").WithBetween(0.5, 1.5).WithValidationCallback(func(in float32) error { return nil }),
+			value:         "2",
+			expectedValue: 2,
+			expectedRange: "[0.5, 1.5]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
 func TestFloat32Flag_ResetToDefault(t *testing.T) {
 	testCases := []struct {
 		title                   string
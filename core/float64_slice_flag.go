@@ -22,13 +22,19 @@ type Float64SliceFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	delimiter           string
 	validate            func(in float64) error
 	validationList      map[float64]interface{}
 	acceptableItems     []string
+	bounds              floatBounds
+	items               itemRules
+	validators          []Validator
 }
 
 // Float64SliceFlag creates a new float64 slice flag.
@@ -68,7 +74,7 @@ func (f *Float64SliceFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *Float64SliceFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -153,21 +159,67 @@ func (f *Float64SliceFlag) Hide() *Float64SliceFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *Float64SliceFlag) WithGroup(name string) *Float64SliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *Float64SliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *Float64SliceFlag) WithDescription(description string) *Float64SliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *Float64SliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *Float64SliceFlag) WithExamples(examples ...string) *Float64SliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *Float64SliceFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *Float64SliceFlag) MarkAsDeprecated() *Float64SliceFlag {
-	f.isDeprecated = true
+func (f *Float64SliceFlag) MarkAsDeprecated(options ...DeprecationOption) *Float64SliceFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *Float64SliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
 func (f *Float64SliceFlag) WithDelimiter(delimiter string) *Float64SliceFlag {
 	if len(delimiter) == 0 {
@@ -187,6 +239,16 @@ func (f *Float64SliceFlag) WithValidationCallback(validate func(in float64) erro
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *Float64SliceFlag) WithValidators(validators ...Validator) *Float64SliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -207,6 +269,71 @@ func (f *Float64SliceFlag) WithValidRange(valid ...float64) *Float64SliceFlag {
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *Float64SliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *Float64SliceFlag) WithMinItems(min int) *Float64SliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *Float64SliceFlag) WithMaxItems(max int) *Float64SliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *Float64SliceFlag) Unique() *Float64SliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *Float64SliceFlag) Constraints() []Constraint {
+	return append(f.items.constraints(), describeValidators(f.validators)...)
+}
+
+// WithMin sets the lower bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMin(x, core.Exclusive)).
+// The bounds will be applied to each item of the list.
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *Float64SliceFlag) WithMin(min float64, bound ...Bound) *Float64SliceFlag {
+	f.bounds.setMin(float64(min), bound)
+	return f
+}
+
+// WithMax sets the upper bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMax(x, core.Exclusive)).
+// The bounds will be applied to each item of the list.
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *Float64SliceFlag) WithMax(max float64, bound ...Bound) *Float64SliceFlag {
+	f.bounds.setMax(float64(max), bound)
+	return f
+}
+
+// WithBetween sets the lower and the upper bounds of the acceptable values.
+//
+// Both bounds are inclusive, unless core.Exclusive has been specified (i.e. WithBetween(x, y, core.Exclusive)).
+// Use WithMin(...) and WithMax(...) methods to define half-open ranges.
+func (f *Float64SliceFlag) WithBetween(min, max float64, bound ...Bound) *Float64SliceFlag {
+	return f.WithMin(min, bound...).WithMax(max, bound...)
+}
+
+// Range returns the bounds of the acceptable values, or nil if the flag is unbounded.
+//
+// The bounds can be defined using WithMin(...), WithMax(...) or WithBetween(...) methods.
+func (f *Float64SliceFlag) Range() *Range {
+	return f.bounds.toRange(formatFloat64)
+}
+
 // Set sets the flag value.
 //
 // The value of a float64 slice flag can be set using a comma (or any custom delimiter) separated string of floating point numbers.
@@ -226,28 +353,57 @@ func (f *Float64SliceFlag) Set(value string) error {
 			return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 		}
 
-		if f.validate != nil {
-			err := f.validate(item)
-			if err != nil {
-				return err
-			}
-		}
-
-		// Validation callback takes priority over validation list
-		if f.validate == nil && len(f.validationList) > 0 {
-			if _, ok := f.validationList[item]; !ok {
-				return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
-			}
+		if err := f.validateItem(item, value); err != nil {
+			return err
 		}
 
 		list = append(list, item)
 	}
 
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
 	f.set(list)
 	f.isSet = true
 	return nil
 }
 
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *Float64SliceFlag) validateItem(item float64, input interface{}) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil && !f.bounds.contains(float64(item)) {
+		return internal.OutOfBoundsErr(input, f.long, f.short, f.Range().String())
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *Float64SliceFlag) validateItems(list []float64) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return fmt.Sprint(list[i])
+	})
+}
+
 // ResetToDefault resets the value of this flag to default if a default value is specified.
 //
 // Calling this method on a flag without a default value will have no effect.
@@ -270,6 +426,19 @@ func (f *Float64SliceFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *Float64SliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item, item); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestFloat64Slice(t *testing.T) {
@@ -579,6 +580,72 @@ func TestFloat64SliceFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestFloat64SliceFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.Float64SliceFlag
+		value         string
+		expectedValue []float64
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.Float64Slice("long", "usage"),
+			value:         "0.25,2",
+			expectedValue: []float64{0.25, 2},
+		},
+		{
+			title:         "all the items within the inclusive lower bound",
+			flag:          flags.Float64Slice("long", "usage").WithMin(0.5),
+			value:         "0.5,2",
+			expectedValue: []float64{0.5, 2},
+			expectedRange: ">= 0.5",
+		},
+		{
+			title:         "an item less than the lower bound",
+			flag:          flags.Float64Slice("long", "usage").WithMin(0.5),
+			value:         "0.5,0.25",
+			expectedValue: []float64{},
+			expectedRange: ">= 0.5",
+			expectedError: "0.25 is not an acceptable value for --long. The expected range is >= 0.5.",
+		},
+		{
+			title:         "an item equal to the exclusive upper bound",
+			flag:          flags.Float64Slice("long", "usage").WithMax(1.5, core.Exclusive),
+			value:         "1,1.5",
+			expectedValue: []float64{},
+			expectedRange: "< 1.5",
+			expectedError: "1.5 is not an acceptable value for --long. The expected range is < 1.5.",
+		},
+		{
+			title:         "all the items within the closed range",
+			flag:          flags.Float64Slice("long", "usage").WithBetween(0.5, 1.5),
+			value:         "0.5,1,1.5",
+			expectedValue: []float64{0.5, 1, 1.5},
+			expectedRange: "[0.5, 1.5]",
+		},
+		{
+			title:         "an item out of the closed range",
+			flag:          flags.Float64Slice("long", "usage").WithBetween(0.5, 1.5),
+			value:         "1,1.75",
+			expectedValue: []float64{},
+			expectedRange: "[0.5, 1.5]",
+			expectedError: "1.75 is not an acceptable value for --long. The expected range is [0.5, 1.5].",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
 func TestFloat64SliceFlag_ResetToDefault(t *testing.T) {
 	empty := make([]float64, 0)
 	testCases := []struct {
@@ -0,0 +1,31 @@
+package core
+
+import "strings"
+
+// GroupProvider is the interface for the flags which can be assigned to a named group.
+//
+// All the built-in flag types implement this interface.
+type GroupProvider interface {
+	// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+	Group() string
+}
+
+// GroupFormatter is an optional interface for the help formatters which can render the group headings.
+//
+// The heading of each group will be written before the flags of that group.
+type GroupFormatter interface {
+	// FormatGroup returns the heading of the group.
+	//
+	// index is the zero based position of the group in the help output.
+	FormatGroup(name string, index int) string
+}
+
+// GroupOf returns the name of the group to which the flag belongs.
+//
+// The method returns an empty string if the flag does not implement GroupProvider interface, or it has not been grouped.
+func GroupOf(f Flag) string {
+	if p, ok := f.(GroupProvider); ok {
+		return strings.TrimSpace(p.Group())
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+type flagWithoutGroup struct {
+	core.Flag
+}
+
+func TestGroupOf(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     core.Flag
+		expected string
+	}{
+		{
+			title: "ungrouped flag",
+			flag:  core.NewString("long", "usage"),
+		},
+		{
+			title:    "grouped string flag",
+			flag:     core.NewString("long", "usage").WithGroup("Networking"),
+			expected: "Networking",
+		},
+		{
+			title:    "grouped counter flag",
+			flag:     core.NewCounter("long", "usage").WithGroup("Logging"),
+			expected: "Logging",
+		},
+		{
+			title:    "grouped int slice flag with white spaces",
+			flag:     core.NewIntSlice("long", "usage").WithGroup("  Ports "),
+			expected: "Ports",
+		},
+		{
+			title:    "grouped mocked flag",
+			flag:     mocks.NewFlag("long", "l").WithGroup("Mocks"),
+			expected: "Mocks",
+		},
+		{
+			title: "flag without group provider",
+			flag:  flagWithoutGroup{Flag: mocks.NewFlag("long", "l").WithGroup("Mocks")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := core.GroupOf(tc.flag)
+			if actual != tc.expected {
+				t.Errorf("Expected group: '%s', Actual: '%s'", tc.expected, actual)
+			}
+		})
+	}
+}
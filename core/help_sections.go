@@ -0,0 +1,37 @@
+package core
+
+// Example represents a usage example of the running tool.
+type Example struct {
+	// Command is the example command line (i.e. "tool --port 8080 config.yml").
+	Command string
+	// Description is an optional explanation of what the example command does.
+	Description string
+}
+
+// HelpSections holds the program level sections of the help output.
+type HelpSections struct {
+	// ProgramName is the name of the running tool.
+	ProgramName string
+	// Usage is the usage line of the running tool (i.e. "tool [flags] <file>").
+	Usage string
+	// Description is a paragraph which describes what the running tool does.
+	Description string
+	// Examples is the list of the usage examples.
+	Examples []Example
+	// Footer is the epilogue which will be printed at the end of the help output.
+	Footer string
+	// Grouped is true if the flags will be printed under their group headings.
+	Grouped bool
+}
+
+// HelpSectionFormatter is an optional interface for the help formatters which can render the program level sections
+// of the help output, such as the usage line, description, examples and footer.
+//
+// The header will be written before the flags and the footer will be written after them.
+// Empty strings will not be written to the help writer.
+type HelpSectionFormatter interface {
+	// FormatHeader returns the string which will be printed before the flags.
+	FormatHeader(sections *HelpSections) string
+	// FormatFooter returns the string which will be printed after the flags.
+	FormatFooter(sections *HelpSections) string
+}
@@ -16,12 +16,17 @@ type Int16Flag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	validate            func(in int16) error
 	validationList      map[int16]interface{}
 	acceptableItems     []string
+	bounds              intBounds
+	validators          []Validator
 }
 
 // NewInt16 creates a new int16 flag.
@@ -60,7 +65,7 @@ func (f *Int16Flag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *Int16Flag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -145,21 +150,67 @@ func (f *Int16Flag) Hide() *Int16Flag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *Int16Flag) WithGroup(name string) *Int16Flag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *Int16Flag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *Int16Flag) WithDescription(description string) *Int16Flag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *Int16Flag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *Int16Flag) WithExamples(examples ...string) *Int16Flag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *Int16Flag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *Int16Flag) MarkAsDeprecated() *Int16Flag {
-	f.isDeprecated = true
+func (f *Int16Flag) MarkAsDeprecated(options ...DeprecationOption) *Int16Flag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *Int16Flag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
 //
 // The set operation will fail if the callback returns an error.
@@ -170,6 +221,20 @@ func (f *Int16Flag) WithValidationCallback(validate func(in int16) error) *Int16
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *Int16Flag) WithValidators(validators ...Validator) *Int16Flag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the descriptions of the validators of the flag.
+func (f *Int16Flag) Constraints() []Constraint {
+	return describeValidators(f.validators)
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -190,6 +255,46 @@ func (f *Int16Flag) WithValidRange(valid ...int16) *Int16Flag {
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *Int16Flag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMin sets the lower bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMin(x, core.Exclusive)).
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *Int16Flag) WithMin(min int16, bound ...Bound) *Int16Flag {
+	f.bounds.setMin(int64(min), bound)
+	return f
+}
+
+// WithMax sets the upper bound of the acceptable values.
+//
+// The bound is inclusive, unless core.Exclusive has been specified (i.e. WithMax(x, core.Exclusive)).
+// Remember that setting the bounds will have no effect if a validation callback has been specified.
+func (f *Int16Flag) WithMax(max int16, bound ...Bound) *Int16Flag {
+	f.bounds.setMax(int64(max), bound)
+	return f
+}
+
+// WithBetween sets the lower and the upper bounds of the acceptable values.
+//
+// Both bounds are inclusive, unless core.Exclusive has been specified (i.e. WithBetween(x, y, core.Exclusive)).
+// Use WithMin(...) and WithMax(...) methods to define half-open ranges.
+func (f *Int16Flag) WithBetween(min, max int16, bound ...Bound) *Int16Flag {
+	return f.WithMin(min, bound...).WithMax(max, bound...)
+}
+
+// Range returns the bounds of the acceptable values, or nil if the flag is unbounded.
+//
+// The bounds can be defined using WithMin(...), WithMax(...) or WithBetween(...) methods.
+func (f *Int16Flag) Range() *Range {
+	return f.bounds.toRange(formatInt)
+}
+
 // Set sets the flag value.
 func (f *Int16Flag) Set(value string) error {
 	value = strings.TrimSpace(value)
@@ -201,22 +306,40 @@ func (f *Int16Flag) Set(value string) error {
 		return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 	}
 
+	if err := f.validateValue(int16(v), value); err != nil {
+		return err
+	}
+
+	f.set(int16(v))
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *Int16Flag) validateValue(v int16, input interface{}) error {
 	if f.validate != nil {
-		err := f.validate(int16(v))
+		err := f.validate(v)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := applyValidators(v, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
 	// Validation callback takes priority over validation list
 	if f.validate == nil && len(f.validationList) > 0 {
-		if _, ok := f.validationList[int16(v)]; !ok {
-			return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
+		if _, ok := f.validationList[v]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
 		}
 	}
+	if f.validate == nil && !f.bounds.contains(int64(v)) {
+		return internal.OutOfBoundsErr(input, f.long, f.short, f.Range().String())
+	}
 
-	f.set(int16(v))
-	f.isSet = true
 	return nil
 }
 
@@ -243,6 +366,14 @@ func (f *Int16Flag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *Int16Flag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue, f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -2,9 +2,12 @@ package core_test
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/validate"
 )
 
 func TestInt(t *testing.T) {
@@ -481,6 +484,173 @@ func TestIntFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestIntFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.IntFlag
+		value         string
+		expectedValue int
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.Int("long", "usage"),
+			value:         "9",
+			expectedValue: 9,
+		},
+		{
+			title:         "value equal to the inclusive lower bound",
+			flag:          flags.Int("long", "usage").WithMin(10),
+			value:         "10",
+			expectedValue: 10,
+			expectedRange: ">= 10",
+		},
+		{
+			title:         "value less than the lower bound",
+			flag:          flags.Int("long", "usage").WithMin(10),
+			value:         "9",
+			expectedRange: ">= 10",
+			expectedError: "9 is not an acceptable value for --long. The expected range is >= 10.",
+		},
+		{
+			title:         "value equal to the exclusive lower bound",
+			flag:          flags.Int("long", "usage").WithMin(10, core.Exclusive),
+			value:         "10",
+			expectedRange: "> 10",
+			expectedError: "10 is not an acceptable value for --long. The expected range is > 10.",
+		},
+		{
+			title:         "value equal to the inclusive upper bound",
+			flag:          flags.Int("long", "usage").WithMax(20),
+			value:         "20",
+			expectedValue: 20,
+			expectedRange: "<= 20",
+		},
+		{
+			title:         "value greater than the upper bound",
+			flag:          flags.Int("long", "usage").WithMax(20),
+			value:         "21",
+			expectedRange: "<= 20",
+			expectedError: "21 is not an acceptable value for --long. The expected range is <= 20.",
+		},
+		{
+			title:         "value equal to the exclusive upper bound",
+			flag:          flags.Int("long", "usage").WithMax(20, core.Exclusive),
+			value:         "20",
+			expectedRange: "< 20",
+			expectedError: "20 is not an acceptable value for --long. The expected range is < 20.",
+		},
+		{
+			title:         "value within the closed range",
+			flag:          flags.Int("long", "usage").WithBetween(10, 20),
+			value:         "15",
+			expectedValue: 15,
+			expectedRange: "[10, 20]",
+		},
+		{
+			title:         "value equal to the bound of the open range",
+			flag:          flags.Int("long", "usage").WithBetween(10, 20, core.Exclusive),
+			value:         "20",
+			expectedRange: "(10, 20)",
+			expectedError: "20 is not an acceptable value for --long. The expected range is (10, 20).",
+		},
+		{
+			title:         "value within the half-open range",
+			flag:          flags.Int("long", "usage").WithMin(10).WithMax(20, core.Exclusive),
+			value:         "10",
+			expectedValue: 10,
+			expectedRange: "[10, 20)",
+		},
+		{
+			title:         "validation callback takes priority over the bounds",
+			flag:          flags.Int("long", "usage").WithBetween(10, 20).WithValidationCallback(func(in int) error { return nil }),
+			value:         "30",
+			expectedValue: 30,
+			expectedRange: "[10, 20]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
+func TestIntFlag_Validators(t *testing.T) {
+	testCases := []struct {
+		title               string
+		flag                *core.IntFlag
+		value               string
+		expectedValue       int
+		expectedConstraints []string
+		expectedError       string
+	}{
+		{
+			title:               "no validators",
+			flag:                flags.Int("long", "usage"),
+			value:               "0",
+			expectedConstraints: []string{},
+		},
+		{
+			title:               "value accepted by the validators",
+			flag:                flags.Int("long", "usage").WithValidators(validate.Between(1, 10), validate.Not(validate.OneOf(5))),
+			value:               "6",
+			expectedValue:       6,
+			expectedConstraints: []string{"range: [1, 10]", "not (one of: 5)"},
+		},
+		{
+			title:               "value rejected by the first validator",
+			flag:                flags.Int("long", "usage").WithShort("l").WithValidators(validate.Between(1, 10), validate.Not(validate.OneOf(5))),
+			value:               "0",
+			expectedConstraints: []string{"range: [1, 10]", "not (one of: 5)"},
+			expectedError:       "0 is not an acceptable value for -l, --long: the expected range is [1, 10].",
+		},
+		{
+			title:               "value rejected by the second validator",
+			flag:                flags.Int("long", "usage").WithValidators(validate.Between(1, 10)).WithValidators(validate.Not(validate.OneOf(5))),
+			value:               "5",
+			expectedConstraints: []string{"range: [1, 10]", "not (one of: 5)"},
+			expectedError:       "5 is not an acceptable value for --long: the value must not satisfy one of: 5.",
+		},
+		{
+			title: "validators are applied regardless of the validation callback",
+			flag: flags.Int("long", "usage").
+				WithValidationCallback(func(in int) error { return nil }).
+				WithValidators(validate.Min(1)),
+			value:               "0",
+			expectedConstraints: []string{"range: >= 1"},
+			expectedError:       "0 is not an acceptable value for --long: the expected range is >= 1.",
+		},
+		{
+			title:               "bounds and validators",
+			flag:                flags.Int("long", "usage").WithMax(100).WithValidators(validate.Custom("must be even", func(v interface{}) error { return nil })),
+			value:               "8",
+			expectedValue:       8,
+			expectedConstraints: []string{"must be even"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			actual := make([]string, 0)
+			for _, c := range tc.flag.Constraints() {
+				actual = append(actual, c.String())
+			}
+			if !reflect.DeepEqual(actual, tc.expectedConstraints) {
+				t.Errorf("Expected Constraints: %v, Actual: %v", tc.expectedConstraints, actual)
+			}
+		})
+	}
+}
+
 func TestIntFlag_ResetToDefault(t *testing.T) {
 	testCases := []struct {
 		title                   string
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestIntSlice(t *testing.T) {
@@ -584,6 +585,117 @@ func TestIntSliceFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestIntSliceFlag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.IntSliceFlag
+		value         string
+		expectedValue []int
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.IntSlice("long", "usage"),
+			value:         "9,30",
+			expectedValue: []int{9, 30},
+		},
+		{
+			title:         "all the items within the inclusive lower bound",
+			flag:          flags.IntSlice("long", "usage").WithMin(10),
+			value:         "10,30",
+			expectedValue: []int{10, 30},
+			expectedRange: ">= 10",
+		},
+		{
+			title:         "an item less than the lower bound",
+			flag:          flags.IntSlice("long", "usage").WithMin(10),
+			value:         "10,9",
+			expectedValue: []int{},
+			expectedRange: ">= 10",
+			expectedError: "9 is not an acceptable value for --long. The expected range is >= 10.",
+		},
+		{
+			title:         "an item equal to the exclusive upper bound",
+			flag:          flags.IntSlice("long", "usage").WithMax(20, core.Exclusive),
+			value:         "15,20",
+			expectedValue: []int{},
+			expectedRange: "< 20",
+			expectedError: "20 is not an acceptable value for --long. The expected range is < 20.",
+		},
+		{
+			title:         "all the items within the closed range",
+			flag:          flags.IntSlice("long", "usage").WithBetween(10, 20),
+			value:         "10,15,20",
+			expectedValue: []int{10, 15, 20},
+			expectedRange: "[10, 20]",
+		},
+		{
+			title:         "an item out of the closed range",
+			flag:          flags.IntSlice("long", "usage").WithBetween(10, 20),
+			value:         "15,21",
+			expectedValue: []int{},
+			expectedRange: "[10, 20]",
+			expectedError: "21 is not an acceptable value for --long. The expected range is [10, 20].",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
+func TestIntSliceFlag_Items(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.IntSliceFlag
+		value         string
+		expectedValue []int
+		expectedError string
+	}{
+		{
+			title:         "number of items within the range",
+			flag:          flags.IntSlice("long", "usage").WithMinItems(2).WithMaxItems(2),
+			value:         "1,2",
+			expectedValue: []int{1, 2},
+		},
+		{
+			title:         "more items than the maximum",
+			flag:          flags.IntSlice("long", "usage").WithMaxItems(1),
+			value:         "1,2",
+			expectedValue: []int{},
+			expectedError: "2 items are not acceptable for --long. The expected number of items is <= 1.",
+		},
+		{
+			title:         "duplicate items with different representations",
+			flag:          flags.IntSlice("long", "usage").Unique(),
+			value:         "1,01",
+			expectedValue: []int{},
+			expectedError: "'1' has been provided more than once for --long. The items must be unique.",
+		},
+		{
+			title:         "item count is validated regardless of the validation callback",
+			flag:          flags.IntSlice("long", "usage").WithMaxItems(1).WithValidationCallback(func(in int) error { return nil }),
+			value:         "1,2",
+			expectedValue: []int{},
+			expectedError: "2 items are not acceptable for --long. The expected number of items is <= 1.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
 func TestIntSliceFlag_ResetToDefault(t *testing.T) {
 	empty := make([]int, 0)
 	testCases := []struct {
@@ -19,12 +19,16 @@ type IPAddressFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	validate            func(in net.IP) error
 	validationList      map[string]interface{}
 	acceptableItems     []string
+	validators          []Validator
 }
 
 // NewIPAddress creates a new IP address flag.
@@ -63,7 +67,7 @@ func (f *IPAddressFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *IPAddressFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -148,21 +152,67 @@ func (f *IPAddressFlag) Hide() *IPAddressFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *IPAddressFlag) WithGroup(name string) *IPAddressFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *IPAddressFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *IPAddressFlag) WithDescription(description string) *IPAddressFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *IPAddressFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *IPAddressFlag) WithExamples(examples ...string) *IPAddressFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *IPAddressFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *IPAddressFlag) MarkAsDeprecated() *IPAddressFlag {
-	f.isDeprecated = true
+func (f *IPAddressFlag) MarkAsDeprecated(options ...DeprecationOption) *IPAddressFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *IPAddressFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
 //
 // The set operation will fail if the callback returns an error.
@@ -173,6 +223,20 @@ func (f *IPAddressFlag) WithValidationCallback(validate func(in net.IP) error) *
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *IPAddressFlag) WithValidators(validators ...Validator) *IPAddressFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the descriptions of the validators of the flag.
+func (f *IPAddressFlag) Constraints() []Constraint {
+	return describeValidators(f.validators)
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -197,6 +261,13 @@ func (f *IPAddressFlag) WithValidRange(valid ...net.IP) *IPAddressFlag {
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *IPAddressFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
 // Set sets the flag value.
 //
 // The value of an IP address flag can be specified using an IPv4 dotted decimal (i.e. "192.0.2.1")
@@ -213,22 +284,37 @@ func (f *IPAddressFlag) Set(value string) error {
 		return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 	}
 
+	if err := f.validateValue(ip, value); err != nil {
+		return err
+	}
+
+	f.set(ip)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *IPAddressFlag) validateValue(v net.IP, input interface{}) error {
 	if f.validate != nil {
-		err := f.validate(ip)
+		err := f.validate(v)
 		if err != nil {
 			return err
 		}
 	}
 
+	if err := applyValidators(v, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
 	// Validation callback takes priority over validation list
 	if f.validate == nil && len(f.validationList) > 0 {
-		if _, ok := f.validationList[ip.String()]; !ok {
-			return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
+		if _, ok := f.validationList[v.String()]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
 		}
 	}
 
-	f.set(ip)
-	f.isSet = true
 	return nil
 }
 
@@ -255,6 +341,14 @@ func (f *IPAddressFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *IPAddressFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue, f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
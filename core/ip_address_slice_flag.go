@@ -1,6 +1,7 @@
 package core
 
 import (
+	"fmt"
 	"net"
 	"strings"
 
@@ -20,13 +21,18 @@ type IPAddressSliceFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	delimiter           string
 	validate            func(in net.IP) error
 	validationList      map[string]interface{}
 	acceptableItems     []string
+	items               itemRules
+	validators          []Validator
 }
 
 // NewIPAddressSlice creates a new IP address slice flag.
@@ -66,7 +72,7 @@ func (f *IPAddressSliceFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *IPAddressSliceFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -151,21 +157,67 @@ func (f *IPAddressSliceFlag) Hide() *IPAddressSliceFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *IPAddressSliceFlag) WithGroup(name string) *IPAddressSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *IPAddressSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *IPAddressSliceFlag) WithDescription(description string) *IPAddressSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *IPAddressSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *IPAddressSliceFlag) WithExamples(examples ...string) *IPAddressSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *IPAddressSliceFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *IPAddressSliceFlag) MarkAsDeprecated() *IPAddressSliceFlag {
-	f.isDeprecated = true
+func (f *IPAddressSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *IPAddressSliceFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *IPAddressSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
 func (f *IPAddressSliceFlag) WithDelimiter(delimiter string) *IPAddressSliceFlag {
 	if len(delimiter) == 0 {
@@ -185,6 +237,16 @@ func (f *IPAddressSliceFlag) WithValidationCallback(validate func(in net.IP) err
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *IPAddressSliceFlag) WithValidators(validators ...Validator) *IPAddressSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -209,6 +271,36 @@ func (f *IPAddressSliceFlag) WithValidRange(valid ...net.IP) *IPAddressSliceFlag
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *IPAddressSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *IPAddressSliceFlag) WithMinItems(min int) *IPAddressSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *IPAddressSliceFlag) WithMaxItems(max int) *IPAddressSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *IPAddressSliceFlag) Unique() *IPAddressSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *IPAddressSliceFlag) Constraints() []Constraint {
+	return append(f.items.constraints(), describeValidators(f.validators)...)
+}
+
 // Set sets the flag value.
 //
 // The value of an IP address slice flag can be specified using a comma (or any custom delimiter) separated string of
@@ -227,28 +319,54 @@ func (f *IPAddressSliceFlag) Set(value string) error {
 			return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 		}
 
-		if f.validate != nil {
-			err := f.validate(ip)
-			if err != nil {
-				return err
-			}
-		}
-
-		// Validation callback takes priority over validation list
-		if f.validate == nil && len(f.validationList) > 0 {
-			if _, ok := f.validationList[ip.String()]; !ok {
-				return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
-			}
+		if err := f.validateItem(ip, value); err != nil {
+			return err
 		}
 
 		list = append(list, ip)
 	}
 
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
 	f.set(list)
 	f.isSet = true
 	return nil
 }
 
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be used in the error messages.
+func (f *IPAddressSliceFlag) validateItem(item net.IP, input interface{}) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item.String()]; !ok {
+			return internal.OutOfRangeErr(input, f.long, f.short, f.acceptableItems)
+		}
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *IPAddressSliceFlag) validateItems(list []net.IP) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return fmt.Sprint(list[i])
+	})
+}
+
 // ResetToDefault resets the value of this flag to default if a default value is specified.
 //
 // Calling this method on a flag without a default value will have no effect.
@@ -272,6 +390,19 @@ func (f *IPAddressSliceFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *IPAddressSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if err := f.validateItem(item, item); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
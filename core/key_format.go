@@ -0,0 +1,50 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// defaultKeyFormatString is the format string of the keys, if no key format has been set on the formatter.
+const defaultKeyFormatString = "[$%s]"
+
+// KeyFormat holds the settings which control how the flag keys are rendered in the help output.
+type KeyFormat struct {
+	// FormatString is the format string of the keys (i.e. "[$%s]").
+	//
+	// The keys will be printed as they are, if the format string is empty.
+	FormatString string
+	// PresenceMark is printed next to the keys which have a value in the environment (i.e. "(set)").
+	//
+	// The value itself will never be printed. The presence of the values will not be checked if the mark is empty.
+	PresenceMark string
+	// Lookup looks up the value of a key in the environment.
+	Lookup func(key string) (string, bool)
+}
+
+// KeyFormatSetter is an optional interface for the help formatters which support customising how keys are rendered.
+type KeyFormatSetter interface {
+	// SetKeyFormat sets the settings which control how the flag keys are rendered in the help output.
+	SetKeyFormat(format *KeyFormat)
+}
+
+// formatKey returns the formatted key, or an empty string if the key is empty.
+func formatKey(format *KeyFormat, key string) string {
+	if internal.IsEmpty(key) {
+		return ""
+	}
+	if format == nil {
+		return fmt.Sprintf(defaultKeyFormatString, key)
+	}
+	formatted := key
+	if !internal.IsEmpty(format.FormatString) {
+		formatted = fmt.Sprintf(format.FormatString, key)
+	}
+	if !internal.IsEmpty(format.PresenceMark) && format.Lookup != nil {
+		if _, ok := format.Lookup(key); ok {
+			formatted += " " + format.PresenceMark
+		}
+	}
+	return formatted
+}
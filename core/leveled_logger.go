@@ -0,0 +1,160 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Level represents the severity of a log event.
+type Level int8
+
+const (
+	// LevelDebug the level of the tracing events (i.e. which source has been queried for which key).
+	LevelDebug Level = iota - 1
+	// LevelInfo the level of the informational events.
+	LevelInfo
+	// LevelWarn the level of the warnings (i.e. the usage of deprecated flags).
+	LevelWarn
+	// LevelError the level of the errors.
+	LevelError
+)
+
+// String returns the string representation of the level.
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return fmt.Sprintf("level(%d)", l)
+	}
+}
+
+// ParseLevel parses the case insensitive string representation of a level (i.e. debug, info, warn or error).
+func ParseLevel(level string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return LevelInfo, fmt.Errorf("%s is not a valid log level. The valid levels are debug, info, warn and error", level)
+	}
+}
+
+// Attr represents a key/value attribute of a log event.
+type Attr struct {
+	Key   string
+	Value interface{}
+}
+
+// NewAttr creates a new log attribute.
+func NewAttr(key string, value interface{}) Attr {
+	return Attr{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// String returns the string representation of the attribute (i.e. key=value).
+func (a Attr) String() string {
+	value := fmt.Sprintf("%v", a.Value)
+	if strings.ContainsAny(value, " \t\n\"=") || value == "" {
+		value = fmt.Sprintf("%q", value)
+	}
+	return a.Key + "=" + value
+}
+
+// LeveledLogger is an optional interface for the loggers which support levels and key/value attributes.
+//
+// The bucket emits debug events throughout the parsing process, if its logger implements this interface.
+// The deprecation warnings will be logged at warning level, whereas the errors will still be reported using the
+// Print method.
+type LeveledLogger interface {
+	Logger
+	// Enabled returns true if the events of the specified level must be logged.
+	Enabled(level Level) bool
+	// Log logs the event.
+	Log(level Level, msg string, attrs ...Attr)
+}
+
+// LevelSetter is an optional interface for the loggers whose minimum level can be changed (i.e. by --log-level).
+type LevelSetter interface {
+	// SetLevel sets the minimum level of the events which must be logged.
+	SetLevel(level Level)
+}
+
+// Event represents a log event which has been passed to a logger without levels (See LoggerAdapter).
+//
+// The underlying loggers can retrieve the level of the event using a type assertion.
+type Event struct {
+	// Level is the severity of the event.
+	Level Level
+	// Msg is the message of the event.
+	Msg string
+	// Attrs are the key/value attributes of the event.
+	Attrs []Attr
+}
+
+// Error returns the string representation of the event (i.e. "parsing flags count=2").
+func (e *Event) Error() string {
+	return FormatEvent(e.Msg, e.Attrs...)
+}
+
+// LoggerAdapter represents a leveled logger which reports the events to a logger without levels.
+//
+// The events will be converted to *Event errors (i.e. "parsing flags count=2") and printed using the underlying logger.
+type LoggerAdapter struct {
+	logger Logger
+	level  Level
+}
+
+// NewLoggerAdapter creates a new leveled logger which reports the events of the specified level and above to the logger.
+func NewLoggerAdapter(logger Logger, level Level) *LoggerAdapter {
+	return &LoggerAdapter{
+		logger: logger,
+		level:  level,
+	}
+}
+
+// Print prints the error using the underlying logger.
+func (l *LoggerAdapter) Print(err error) {
+	l.logger.Print(err)
+}
+
+// Enabled returns true if the level is greater than or equal to the minimum level of the adapter.
+func (l *LoggerAdapter) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+// Log prints the event using the underlying logger, if the level is enabled.
+func (l *LoggerAdapter) Log(level Level, msg string, attrs ...Attr) {
+	if !l.Enabled(level) {
+		return
+	}
+	l.logger.Print(&Event{Level: level, Msg: msg, Attrs: attrs})
+}
+
+// SetLevel sets the minimum level of the events which must be logged.
+func (l *LoggerAdapter) SetLevel(level Level) {
+	l.level = level
+}
+
+// FormatEvent returns the string representation of a log event (i.e. parsing flags count=2).
+func FormatEvent(msg string, attrs ...Attr) string {
+	sb := &strings.Builder{}
+	sb.WriteString(msg)
+	for _, attr := range attrs {
+		sb.WriteString(" " + attr.String())
+	}
+	return sb.String()
+}
@@ -0,0 +1,117 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func TestParseLevel(t *testing.T) {
+	testCases := []struct {
+		input       string
+		expected    core.Level
+		expectError bool
+	}{
+		{input: "debug", expected: core.LevelDebug},
+		{input: " DEBUG ", expected: core.LevelDebug},
+		{input: "info", expected: core.LevelInfo},
+		{input: "warn", expected: core.LevelWarn},
+		{input: "Warning", expected: core.LevelWarn},
+		{input: "error", expected: core.LevelError},
+		{input: "verbose", expected: core.LevelInfo, expectError: true},
+		{input: "", expected: core.LevelInfo, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			actual, err := core.ParseLevel(tc.input)
+			if (err != nil) != tc.expectError {
+				t.Errorf("Expected error: %v, Actual: %v", tc.expectError, err)
+			}
+			if actual != tc.expected {
+				t.Errorf("Expected level: %v, Actual: %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLevel_String(t *testing.T) {
+	levels := map[core.Level]string{
+		core.LevelDebug: "debug",
+		core.LevelInfo:  "info",
+		core.LevelWarn:  "warn",
+		core.LevelError: "error",
+		core.Level(10):  "level(10)",
+	}
+	for level, expected := range levels {
+		if actual := level.String(); actual != expected {
+			t.Errorf("Expected %q, Actual: %q", expected, actual)
+		}
+	}
+}
+
+func TestFormatEvent(t *testing.T) {
+	testCases := []struct {
+		title    string
+		msg      string
+		attrs    []core.Attr
+		expected string
+	}{
+		{
+			title:    "without attributes",
+			msg:      "parsing completed",
+			expected: "parsing completed",
+		},
+		{
+			title:    "with attributes",
+			msg:      "source queried",
+			attrs:    []core.Attr{core.NewAttr("flag", "port"), core.NewAttr("found", true)},
+			expected: "source queried flag=port found=true",
+		},
+		{
+			title:    "quoted values",
+			msg:      "source skipped",
+			attrs:    []core.Attr{core.NewAttr("reason", "no key"), core.NewAttr("empty", "")},
+			expected: `source skipped reason="no key" empty=""`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := core.FormatEvent(tc.msg, tc.attrs...)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLoggerAdapter(t *testing.T) {
+	lg := &mocks.Logger{}
+	adapter := core.NewLoggerAdapter(lg, core.LevelWarn)
+
+	adapter.Log(core.LevelDebug, "debug event")
+	if lg.Error != nil {
+		t.Errorf("Expected the debug event to be ignored, Actual: %v", lg.Error)
+	}
+
+	adapter.Log(core.LevelWarn, "warning", core.NewAttr("flag", "port"))
+	if lg.Error == nil || lg.Error.Error() != "warning flag=port" {
+		t.Errorf("Expected the warning to be printed, Actual: %v", lg.Error)
+	}
+	if e, ok := lg.Error.(*core.Event); !ok || e.Level != core.LevelWarn {
+		t.Errorf("Expected the event to keep its level, Actual: %#v", lg.Error)
+	}
+
+	adapter.SetLevel(core.LevelDebug)
+	if !adapter.Enabled(core.LevelDebug) {
+		t.Errorf("Expected the debug level to be enabled")
+	}
+
+	warning := core.NewDeprecationWarning("old", "", nil)
+	adapter.Print(warning)
+	if lg.Error != warning {
+		t.Errorf("Expected the error to be passed to the underlying logger, Actual: %v", lg.Error)
+	}
+}
@@ -2,7 +2,7 @@ package core
 
 // Logger is the interface that adds logging functionality to the package.
 //
-// A logger is only used to print out the runtime errors.
+// A logger is used to print out the runtime errors and warnings (i.e. core.DeprecationWarning).
 type Logger interface {
 	Print(error)
 }
@@ -0,0 +1,283 @@
+package core
+
+import "github.com/xitonix/flags/internal"
+
+// The identifiers of the user-facing messages.
+//
+// The arguments of each message are listed in order, next to its identifier. The messages without any
+// arguments will be used as they are. Some of them are format strings themselves (i.e. MsgDefaultValue).
+const (
+	// MsgInvalidValue value, flag type and flag name (i.e. 'abc' is not a valid int value for -p, --port).
+	MsgInvalidValue = internal.MsgInvalidValue
+	// MsgOutOfRange value and flag name (i.e. 10 is not an acceptable value for --port.).
+	MsgOutOfRange = internal.MsgOutOfRange
+	// MsgOutOfRangeValue value, flag name and the only valid value.
+	MsgOutOfRangeValue = internal.MsgOutOfRangeValue
+	// MsgOutOfRangeValues value, flag name and the comma separated list of valid values.
+	MsgOutOfRangeValues = internal.MsgOutOfRangeValues
+	// MsgOutOfRangeTime value, long name and the list of valid values of a time flag.
+	MsgOutOfRangeTime = internal.MsgOutOfRangeTime
+	// MsgOutOfBounds value, flag name and the acceptable range (i.e. [1, 65535]).
+	MsgOutOfBounds = internal.MsgOutOfBounds
+	// MsgEmptyValue flag name (i.e. --name cannot be empty.).
+	MsgEmptyValue = internal.MsgEmptyValue
+	// MsgLengthOutOfBounds value, flag name and the acceptable range of the length (i.e. [3, 10]).
+	MsgLengthOutOfBounds = internal.MsgLengthOutOfBounds
+	// MsgPatternMismatch value, flag name and the regular expression which the value must match.
+	MsgPatternMismatch = internal.MsgPatternMismatch
+	// MsgInvalidFormat value, the name of the expected format (i.e. email address) and flag name.
+	MsgInvalidFormat = internal.MsgInvalidFormat
+	// MsgItemCountOutOfBounds number of the items, flag name and the acceptable range of the number of items.
+	MsgItemCountOutOfBounds = internal.MsgItemCountOutOfBounds
+	// MsgDuplicateItem the duplicate item and flag name.
+	MsgDuplicateItem = internal.MsgDuplicateItem
+	// MsgInvalidScheme value, flag name and the comma separated list of acceptable URL schemes.
+	MsgInvalidScheme = internal.MsgInvalidScheme
+	// MsgMissingHost value and flag name of a URL without a host.
+	MsgMissingHost = internal.MsgMissingHost
+	// MsgMissingPort value and flag name of an endpoint without a port.
+	MsgMissingPort = internal.MsgMissingPort
+	// MsgPortOutOfBounds value, flag name and the acceptable range of the port (i.e. [1024, 65535]).
+	MsgPortOutOfBounds = internal.MsgPortOutOfBounds
+	// MsgPathNotFound value and flag name of a path which does not exist.
+	MsgPathNotFound = internal.MsgPathNotFound
+	// MsgPathExists value and flag name of a path which already exists.
+	MsgPathExists = internal.MsgPathExists
+	// MsgPathNotReadable value and flag name of a path which cannot be read.
+	MsgPathNotReadable = internal.MsgPathNotReadable
+	// MsgPathNotWritable value and flag name of a path which cannot be written.
+	MsgPathNotWritable = internal.MsgPathNotWritable
+	// MsgNotDirectory value and flag name of a directory flag whose path is not a directory.
+	MsgNotDirectory = internal.MsgNotDirectory
+	// MsgIsDirectory value and flag name of a file flag whose path is a directory.
+	MsgIsDirectory = internal.MsgIsDirectory
+	// MsgInvalidExtension value, flag name and the comma separated list of acceptable file extensions.
+	MsgInvalidExtension = internal.MsgInvalidExtension
+	// MsgFileRead flag name, the file path (or stdin) and the error of reading the file.
+	MsgFileRead = internal.MsgFileRead
+	// MsgFileTooLarge the file path (or stdin), flag name and the maximum size (i.e. 1MiB).
+	MsgFileTooLarge = internal.MsgFileTooLarge
+	// MsgValidationFailed value, flag name and the error of the validator which has rejected the value.
+	MsgValidationFailed = internal.MsgValidationFailed
+	// MsgValidateRange the acceptable range of values (i.e. the expected range is [1, 10]). Used by validate.Between.
+	MsgValidateRange = internal.MsgValidateRange
+	// MsgValidatePattern the regular expression which the value must match. Used by validate.Pattern.
+	MsgValidatePattern = internal.MsgValidatePattern
+	// MsgValidateOneOf the comma separated list of valid values. Used by validate.OneOf.
+	MsgValidateOneOf = internal.MsgValidateOneOf
+	// MsgValidateNot the description of the negated validator. Used by validate.Not.
+	MsgValidateNot = internal.MsgValidateNot
+	// MsgValidateAny the descriptions of the alternative validators. Used by validate.Any.
+	MsgValidateAny = internal.MsgValidateAny
+	// MsgValidateType the bound which cannot be compared with the value (i.e. comparing a string with a number).
+	MsgValidateType = internal.MsgValidateType
+	// MsgRequired flag name (i.e. --port flag is required.).
+	MsgRequired = internal.MsgRequired
+	// MsgDeprecatedRequired flag name of a deprecated flag which has been marked as required.
+	MsgDeprecatedRequired = internal.MsgDeprecatedRequired
+	// MsgUnknownFlag flag name (i.e. --port is an unknown flag).
+	MsgUnknownFlag = internal.MsgUnknownFlag
+	// MsgReservedFlag the registration error of the flags whose names have been reserved.
+	MsgReservedFlag = internal.MsgReservedFlag
+	// MsgFlagExists the registration error of the flags whose names have already been registered.
+	MsgFlagExists = internal.MsgFlagExists
+	// MsgInvalidShortName the registration error of the short forms with more than one character.
+	MsgInvalidShortName = internal.MsgInvalidShortName
+	// MsgKeyExists the registration error of the flags whose keys have already been registered.
+	MsgKeyExists = internal.MsgKeyExists
+	// MsgSelfReplacement the registration error of the deprecated flags which replace themselves.
+	MsgSelfReplacement = internal.MsgSelfReplacement
+	// MsgMissingReplacement long name of the replacement flag which has not been registered.
+	MsgMissingReplacement = internal.MsgMissingReplacement
+	// MsgInvalidDefault the validation error of the default value (i.e. has an invalid default value. 0 is not...).
+	MsgInvalidDefault = internal.MsgInvalidDefault
+	// MsgInvalidRange the bounds of a flag which no value can fall within (i.e. [10, 1]).
+	MsgInvalidRange = internal.MsgInvalidRange
+	// MsgInvalidConstraint the description of a constraint which no value can satisfy (i.e. length: [10, 5]).
+	MsgInvalidConstraint = internal.MsgInvalidConstraint
+	// MsgUnknownFileFlag the registration error of the unknown flags whose values must be read from a file.
+	MsgUnknownFileFlag = internal.MsgUnknownFileFlag
+	// MsgUnsupportedShell the requested shell followed by the supported shells (i.e. bash, zsh and fish).
+	MsgUnsupportedShell = internal.MsgUnsupportedShell
+	// MsgDeprecated flag name (i.e. --port is deprecated.).
+	MsgDeprecated = internal.MsgDeprecated
+	// MsgUseReplacement long name of the replacement flag (i.e. Use --port instead.).
+	MsgUseReplacement = internal.MsgUseReplacement
+	// MsgDefaultValue the default value format string (i.e. (default: %v)).
+	MsgDefaultValue = internal.MsgDefaultValue
+	// MsgDeprecationMark the deprecation mark (i.e. [DEPRECATED]).
+	MsgDeprecationMark = internal.MsgDeprecationMark
+	// MsgDefaultGroupTitle the heading of the ungrouped flags (i.e. General).
+	MsgDefaultGroupTitle = internal.MsgDefaultGroupTitle
+	// MsgHelpUsage the usage of the built-in help flag in the shell completions (i.e. Show help).
+	MsgHelpUsage = internal.MsgHelpUsage
+	// MsgVersionUsage the usage of the built-in version flag in the shell completions (i.e. Print version information).
+	MsgVersionUsage = internal.MsgVersionUsage
+	// MsgUsageHeading the heading of the usage line (i.e. Usage:).
+	MsgUsageHeading = internal.MsgUsageHeading
+	// MsgFlagsHeading the heading of the flags section (i.e. Flags:).
+	MsgFlagsHeading = internal.MsgFlagsHeading
+	// MsgExamplesHeading the heading of the examples section (i.e. Examples:).
+	MsgExamplesHeading = internal.MsgExamplesHeading
+	// MsgEnvironmentLabel the label of the flag key on the help page of a flag.
+	MsgEnvironmentLabel = internal.MsgEnvironmentLabel
+	// MsgDefaultLabel the label of the default value on the help page of a flag.
+	MsgDefaultLabel = internal.MsgDefaultLabel
+	// MsgValidValuesLabel the label of the valid values.
+	MsgValidValuesLabel = internal.MsgValidValuesLabel
+	// MsgRangeLabel the label of the acceptable range of values.
+	MsgRangeLabel = internal.MsgRangeLabel
+	// MsgConstraintsLabel the label of the constraints on the help page of a flag.
+	MsgConstraintsLabel = internal.MsgConstraintsLabel
+	// MsgGroupLabel the label of the group on the help page of a flag.
+	MsgGroupLabel = internal.MsgGroupLabel
+	// MsgStatusLabel the label of the status on the help page of a flag.
+	MsgStatusLabel = internal.MsgStatusLabel
+	// MsgDeprecatedLabel the label of the deprecation note on the help page of a flag.
+	MsgDeprecatedLabel = internal.MsgDeprecatedLabel
+	// MsgCurrentValueLabel the label of the resolved value on the help page of a flag.
+	MsgCurrentValueLabel = internal.MsgCurrentValueLabel
+	// MsgSourceLabel the label of the value provenance on the help page of a flag.
+	MsgSourceLabel = internal.MsgSourceLabel
+	// MsgStatusRequired the required status (i.e. required).
+	MsgStatusRequired = internal.MsgStatusRequired
+	// MsgStatusDeprecated the deprecated status (i.e. deprecated).
+	MsgStatusDeprecated = internal.MsgStatusDeprecated
+	// MsgStatusHidden the hidden status (i.e. hidden).
+	MsgStatusHidden = internal.MsgStatusHidden
+	// MsgOneOf the comma separated list of valid values (i.e. one of: a, b).
+	MsgOneOf = internal.MsgOneOf
+	// MsgRange the acceptable range of values (i.e. range: [1, 65535]).
+	MsgRange = internal.MsgRange
+	// MsgNonEmpty the constraint of the values which cannot be empty (i.e. non-empty).
+	MsgNonEmpty = internal.MsgNonEmpty
+	// MsgLength the acceptable range of the length of the value (i.e. length: [3, 10]).
+	MsgLength = internal.MsgLength
+	// MsgPattern the regular expression which the value must match (i.e. pattern: ^[a-z]+$).
+	MsgPattern = internal.MsgPattern
+	// MsgFormat the expected format of the value (i.e. format: email address).
+	MsgFormat = internal.MsgFormat
+	// MsgItems the acceptable range of the number of items (i.e. items: [1, 3]).
+	MsgItems = internal.MsgItems
+	// MsgUnique the constraint of the lists whose items must be unique (i.e. unique).
+	MsgUnique = internal.MsgUnique
+	// MsgSchemes the comma separated list of acceptable URL schemes (i.e. schemes: https, grpc).
+	MsgSchemes = internal.MsgSchemes
+	// MsgHostRequired the constraint of the URLs which must have a host (i.e. host required).
+	MsgHostRequired = internal.MsgHostRequired
+	// MsgPort the acceptable range of the endpoint ports (i.e. port: [1024, 65535]).
+	MsgPort = internal.MsgPort
+	// MsgMustExist the constraint of the paths which must exist (i.e. must exist).
+	MsgMustExist = internal.MsgMustExist
+	// MsgMustNotExist the constraint of the paths which must not exist (i.e. must not exist).
+	MsgMustNotExist = internal.MsgMustNotExist
+	// MsgReadable the constraint of the paths which must be readable (i.e. readable).
+	MsgReadable = internal.MsgReadable
+	// MsgWritable the constraint of the paths which must be writable (i.e. writable).
+	MsgWritable = internal.MsgWritable
+	// MsgExtensions the comma separated list of acceptable file extensions (i.e. extensions: .yaml, .json).
+	MsgExtensions = internal.MsgExtensions
+	// MsgNot the description of a negated validator (i.e. not (one of: a, b)).
+	MsgNot = internal.MsgNot
+	// MsgAll the descriptions of the validators which must all pass (i.e. all of (range: >= 1, pattern: ^\d+$)).
+	MsgAll = internal.MsgAll
+	// MsgAny the descriptions of the alternative validators (i.e. any of (range: <= 0, range: >= 10)).
+	MsgAny = internal.MsgAny
+	// MsgRequiredNote the note of the mandatory flags in the generated documentation (i.e. Required.).
+	MsgRequiredNote = internal.MsgRequiredNote
+	// MsgDeprecatedNote the note of the deprecated flags in the generated documentation (i.e. Deprecated.).
+	MsgDeprecatedNote = internal.MsgDeprecatedNote
+	// MsgManualTitle the title of the manual in the generated man pages (i.e. User Commands).
+	MsgManualTitle = internal.MsgManualTitle
+	// MsgNameSection the name section of the generated man pages (i.e. Name).
+	MsgNameSection = internal.MsgNameSection
+	// MsgSynopsisSection the synopsis section of the generated documentation (i.e. Synopsis).
+	MsgSynopsisSection = internal.MsgSynopsisSection
+	// MsgDescriptionSection the description section of the generated man pages (i.e. Description).
+	MsgDescriptionSection = internal.MsgDescriptionSection
+	// MsgOptionsSection the options section of the generated man pages (i.e. Options).
+	MsgOptionsSection = internal.MsgOptionsSection
+	// MsgFlagsSection the flags section of the generated Markdown documentation (i.e. Flags).
+	MsgFlagsSection = internal.MsgFlagsSection
+	// MsgFlagColumn the flag names column of the generated Markdown documentation (i.e. Flag).
+	MsgFlagColumn = internal.MsgFlagColumn
+	// MsgTypeColumn the flag type column of the generated Markdown documentation (i.e. Type).
+	MsgTypeColumn = internal.MsgTypeColumn
+	// MsgEnvironmentColumn the flag key column of the generated Markdown documentation (i.e. Environment Variable).
+	MsgEnvironmentColumn = internal.MsgEnvironmentColumn
+	// MsgDefaultColumn the default value column of the generated Markdown documentation (i.e. Default).
+	MsgDefaultColumn = internal.MsgDefaultColumn
+	// MsgDescriptionColumn the usage column of the generated Markdown documentation (i.e. Description).
+	MsgDescriptionColumn = internal.MsgDescriptionColumn
+	// MsgOriginUnset the origin of the flags which have not been set.
+	MsgOriginUnset = internal.MsgOriginUnset
+	// MsgOriginDefault the origin of the default values.
+	MsgOriginDefault = internal.MsgOriginDefault
+	// MsgOriginCommandLine the origin of the command line arguments.
+	MsgOriginCommandLine = internal.MsgOriginCommandLine
+	// MsgOriginEnvironment the origin of the environment variables.
+	MsgOriginEnvironment = internal.MsgOriginEnvironment
+	// MsgOriginSource the origin of the values provided by custom sources.
+	MsgOriginSource = internal.MsgOriginSource
+	// MsgOriginForwarded the origin of the values forwarded from deprecated flags.
+	MsgOriginForwarded = internal.MsgOriginForwarded
+)
+
+// MessageCatalogue is the interface for translating the user-facing messages.
+type MessageCatalogue interface {
+	// Message returns the text of the message with the specified identifier, formatted using the arguments.
+	Message(id string, args ...interface{}) string
+}
+
+// EnglishCatalogue represents the default message catalogue.
+type EnglishCatalogue struct{}
+
+// Message returns the English text of the message with the specified identifier.
+//
+// The identifier will be returned if the message does not exist.
+func (EnglishCatalogue) Message(id string, args ...interface{}) string {
+	return internal.EnglishMessage(id, args...)
+}
+
+// MessageMap represents a message catalogue which maps the message identifiers to format strings.
+//
+// The English text will be used for the messages which do not exist in the map.
+//
+// Example:
+//
+// 	catalogue := core.MessageMap{
+// 		core.MsgRequired:        "%s ist erforderlich.",
+// 		core.MsgDeprecationMark: "[VERALTET]",
+// 	}
+type MessageMap map[string]string
+
+// Message returns the text of the message with the specified identifier, formatted using the arguments.
+func (m MessageMap) Message(id string, args ...interface{}) string {
+	if format, ok := m[id]; ok {
+		return internal.FormatMessage(format, args...)
+	}
+	return internal.EnglishMessage(id, args...)
+}
+
+// LocalisableError is the interface for the errors and warnings which can be translated using a message catalogue.
+//
+// The bucket translates the localisable errors using its message catalogue before reporting them to the logger.
+type LocalisableError interface {
+	error
+	// Localise translates the message using the specified message function (i.e. catalogue.Message).
+	Localise(message func(id string, args ...interface{}) string)
+}
+
+// MessageCatalogueSetter is an optional interface for the help formatters which can translate their output.
+type MessageCatalogueSetter interface {
+	// SetMessageCatalogue sets the catalogue which will be used to translate the help output.
+	SetMessageCatalogue(catalogue MessageCatalogue)
+}
+
+// messageOf returns the text of the message using the catalogue, or in English if the catalogue is nil.
+func messageOf(catalogue MessageCatalogue, id string, args ...interface{}) string {
+	if catalogue == nil {
+		return internal.EnglishMessage(id, args...)
+	}
+	return catalogue.Message(id, args...)
+}
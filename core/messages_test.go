@@ -0,0 +1,117 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestMessageCatalogue_Message(t *testing.T) {
+	testCases := []struct {
+		title     string
+		catalogue core.MessageCatalogue
+		id        string
+		args      []interface{}
+		expected  string
+	}{
+		{
+			title:     "english message with arguments",
+			catalogue: core.EnglishCatalogue{},
+			id:        core.MsgRequired,
+			args:      []interface{}{"--port"},
+			expected:  "--port flag is required.",
+		},
+		{
+			title:     "english format string without arguments",
+			catalogue: core.EnglishCatalogue{},
+			id:        core.MsgDefaultValue,
+			expected:  "(default: %v)",
+		},
+		{
+			title:     "unknown english message",
+			catalogue: core.EnglishCatalogue{},
+			id:        "unknown",
+			expected:  "unknown",
+		},
+		{
+			title:     "translated message",
+			catalogue: core.MessageMap{core.MsgRequired: "%s ist erforderlich."},
+			id:        core.MsgRequired,
+			args:      []interface{}{"--port"},
+			expected:  "--port ist erforderlich.",
+		},
+		{
+			title:     "message map english fallback",
+			catalogue: core.MessageMap{core.MsgRequired: "%s ist erforderlich."},
+			id:        core.MsgUnknownFlag,
+			args:      []interface{}{"--port"},
+			expected:  "--port is an unknown flag",
+		},
+		{
+			title:     "nil message map",
+			catalogue: core.MessageMap(nil),
+			id:        core.MsgDeprecationMark,
+			expected:  "[DEPRECATED]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := tc.catalogue.Message(tc.id, tc.args...)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLocalisableError(t *testing.T) {
+	catalogue := core.MessageMap{
+		core.MsgUnknownFlag:    "%s ist ein unbekanntes Flag",
+		core.MsgDeprecated:     "%s ist veraltet.",
+		core.MsgUseReplacement: "Verwenden Sie stattdessen --%s.",
+	}
+	testCases := []struct {
+		title      string
+		err        core.LocalisableError
+		expected   string
+		translated string
+	}{
+		{
+			title:      "unknown flag",
+			err:        core.NewUnknownFlagErr("--port"),
+			expected:   "--port is an unknown flag",
+			translated: "--port ist ein unbekanntes Flag",
+		},
+		{
+			title:      "deprecation warning",
+			err:        core.NewDeprecationWarning("old", "o", &core.Deprecation{Message: "Legacy.", Replacement: "new"}),
+			expected:   "-o, --old is deprecated. Legacy. Use --new instead.",
+			translated: "-o, --old ist veraltet. Legacy. Verwenden Sie stattdessen --new.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			if tc.err.Error() != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, tc.err.Error())
+			}
+			tc.err.Localise(catalogue.Message)
+			if tc.err.Error() != tc.translated {
+				t.Errorf("Expected %q, Actual: %q", tc.translated, tc.err.Error())
+			}
+		})
+	}
+}
+
+func TestProvenance_Format(t *testing.T) {
+	catalogue := core.MessageMap{core.MsgOriginEnvironment: "Umgebung"}
+	p := &core.Provenance{Origin: core.Environment, Name: "PORT"}
+	if actual := p.Format(catalogue); actual != "Umgebung ($PORT)" {
+		t.Errorf("Expected %q, Actual: %q", "Umgebung ($PORT)", actual)
+	}
+	var unset *core.Provenance
+	if actual := unset.Format(catalogue); actual != "unset" {
+		t.Errorf("Expected %q, Actual: %q", "unset", actual)
+	}
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// pathRules holds the resolution options and the declarative validators of the path flags.
+type pathRules struct {
+	isDir        bool
+	expandHome   bool
+	expandEnv    bool
+	mustExist    bool
+	mustNotExist bool
+	readable     bool
+	writable     bool
+	extensions   []string
+}
+
+func (r *pathRules) setExtensions(extensions []string) {
+	r.extensions = make([]string, 0)
+	for _, ext := range extensions {
+		ext = strings.ToLower(strings.TrimSpace(ext))
+		if internal.IsEmpty(ext) {
+			continue
+		}
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+		r.extensions = append(r.extensions, ext)
+	}
+}
+
+// resolve expands the home directory and the environment variables (if enabled) and returns the absolute path.
+func (r *pathRules) resolve(value string) (string, error) {
+	if r.expandEnv {
+		value = os.ExpandEnv(value)
+	}
+	if r.expandHome && (value == "~" || strings.HasPrefix(value, "~/") || strings.HasPrefix(value, "~"+string(filepath.Separator))) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		value = filepath.Join(home, value[1:])
+	}
+	return filepath.Abs(value)
+}
+
+// checkExtension validates the extension of the path.
+func (r *pathRules) checkExtension(path, input, long, short string) error {
+	if len(r.extensions) == 0 {
+		return nil
+	}
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, e := range r.extensions {
+		if e == ext {
+			return nil
+		}
+	}
+	return internal.NewMessageErr(internal.MsgInvalidExtension, input, internal.GetPrintName(long, short), strings.Join(r.extensions, ", "))
+}
+
+// check validates the path against the file system.
+//
+// The input will be used in the error messages.
+func (r *pathRules) check(path, input, long, short string) error {
+	if err := r.checkExtension(path, input, long, short); err != nil {
+		return err
+	}
+	name := internal.GetPrintName(long, short)
+	info, err := os.Stat(path)
+	exists := err == nil
+	if r.mustNotExist && exists {
+		return internal.NewMessageErr(internal.MsgPathExists, input, name)
+	}
+	if !exists {
+		if r.mustExist || r.readable {
+			return internal.NewMessageErr(internal.MsgPathNotFound, input, name)
+		}
+		// The path will be created by the application, so the parent directory must be writable.
+		if r.writable && !internal.IsWritable(filepath.Dir(path)) {
+			return internal.NewMessageErr(internal.MsgPathNotWritable, input, name)
+		}
+		return nil
+	}
+	if r.isDir && !info.IsDir() {
+		return internal.NewMessageErr(internal.MsgNotDirectory, input, name)
+	}
+	if !r.isDir && info.IsDir() {
+		return internal.NewMessageErr(internal.MsgIsDirectory, input, name)
+	}
+	if r.readable && !internal.IsReadable(path) {
+		return internal.NewMessageErr(internal.MsgPathNotReadable, input, name)
+	}
+	if r.writable && !internal.IsWritable(path) {
+		return internal.NewMessageErr(internal.MsgPathNotWritable, input, name)
+	}
+	return nil
+}
+
+func (r *pathRules) constraints() []Constraint {
+	constraints := make([]Constraint, 0)
+	if r.mustExist {
+		constraints = append(constraints, NewConstraint(MsgMustExist))
+	}
+	if r.mustNotExist {
+		constraints = append(constraints, NewConstraint(MsgMustNotExist))
+	}
+	if r.readable {
+		constraints = append(constraints, NewConstraint(MsgReadable))
+	}
+	if r.writable {
+		constraints = append(constraints, NewConstraint(MsgWritable))
+	}
+	if len(r.extensions) > 0 {
+		constraints = append(constraints, NewConstraint(MsgExtensions, strings.Join(r.extensions, ", ")))
+	}
+	return constraints
+}
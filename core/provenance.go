@@ -0,0 +1,90 @@
+package core
+
+// Origin represents the origin of a flag value.
+type Origin int8
+
+const (
+	// Unset the flag has not been set by any of the sources and it does not have a default value.
+	Unset Origin = iota
+	// DefaultValue the flag has been set to its default value.
+	DefaultValue
+	// CommandLine the value has been provided by the command line arguments.
+	CommandLine
+	// Environment the value has been provided by the environment variables.
+	Environment
+	// CustomSource the value has been provided by a custom source.
+	CustomSource
+	// Forwarded the value has been forwarded from a deprecated flag.
+	Forwarded
+)
+
+// String returns the string representation of the origin.
+func (o Origin) String() string {
+	return o.Format(nil)
+}
+
+// Format returns the string representation of the origin, translated using the message catalogue.
+//
+// The English text will be returned if the catalogue is nil.
+func (o Origin) Format(catalogue MessageCatalogue) string {
+	switch o {
+	case DefaultValue:
+		return messageOf(catalogue, MsgOriginDefault)
+	case CommandLine:
+		return messageOf(catalogue, MsgOriginCommandLine)
+	case Environment:
+		return messageOf(catalogue, MsgOriginEnvironment)
+	case CustomSource:
+		return messageOf(catalogue, MsgOriginSource)
+	case Forwarded:
+		return messageOf(catalogue, MsgOriginForwarded)
+	default:
+		return messageOf(catalogue, MsgOriginUnset)
+	}
+}
+
+// Provenance holds the details of where the value of a flag came from.
+type Provenance struct {
+	// Origin is the origin of the value.
+	Origin Origin
+	// Name is the name by which the value has been provided.
+	//
+	// It is the flag name for the command line arguments (i.e. --port or -p), the key for the environment variables
+	// and the custom sources, and the long name of the deprecated flag for the forwarded values.
+	Name string
+	// Source is the source which has provided the value, or nil if the value has not been provided by any source.
+	Source Source
+	// Value is the raw value which has been provided by the source.
+	Value string
+}
+
+// String returns the string representation of the provenance (i.e. "command line (--port)").
+func (p *Provenance) String() string {
+	return p.Format(nil)
+}
+
+// Format returns the string representation of the provenance, translated using the message catalogue.
+//
+// The English text will be returned if the catalogue is nil.
+func (p *Provenance) Format(catalogue MessageCatalogue) string {
+	if p == nil {
+		return Unset.Format(catalogue)
+	}
+	origin := p.Origin.Format(catalogue)
+	switch p.Origin {
+	case CommandLine, CustomSource:
+		return origin + " (" + p.Name + ")"
+	case Environment:
+		return origin + " ($" + p.Name + ")"
+	case Forwarded:
+		return origin + " (--" + p.Name + ")"
+	default:
+		return origin
+	}
+}
+
+// ProvenanceAware is an optional interface for the help formatters which can render the provenance of the flag values.
+type ProvenanceAware interface {
+	// SetProvenanceLookup sets the function which returns the provenance of a flag's value.
+	SetProvenanceLookup(lookup func(f Flag) *Provenance)
+}
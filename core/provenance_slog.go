@@ -0,0 +1,18 @@
+//go:build go1.21
+// +build go1.21
+
+package core
+
+import "log/slog"
+
+// LogValue returns the origin and the name of the provenance as a slog group.
+//
+// The value itself will not be logged, since it may contain sensitive information.
+func (p *Provenance) LogValue() slog.Value {
+	if p == nil {
+		return slog.GroupValue(slog.String("origin", Unset.String()))
+	}
+	return slog.GroupValue(
+		slog.String("origin", p.Origin.String()),
+		slog.String("name", p.Name))
+}
@@ -0,0 +1,258 @@
+package core
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Bound specifies whether the boundary value of a range is acceptable.
+type Bound int8
+
+const (
+	// Inclusive the boundary value itself is acceptable (i.e. >= 1).
+	Inclusive Bound = iota
+	// Exclusive the boundary value itself is not acceptable (i.e. > 0).
+	Exclusive
+)
+
+// RangeProvider is the interface for the flags whose values must fall within a range.
+//
+// All the built-in numeric and duration flags implement this interface (See WithMin, WithMax and WithBetween).
+type RangeProvider interface {
+	// Range returns the bounds of the acceptable values, or nil if the flag is unbounded.
+	Range() *Range
+}
+
+// Range represents the lower and/or the upper bound of the acceptable values of a flag.
+type Range struct {
+	// Min is the string representation of the lower bound, or an empty string if there is no lower bound.
+	Min string
+	// Max is the string representation of the upper bound, or an empty string if there is no upper bound.
+	Max string
+	// MinBound specifies whether the lower bound itself is an acceptable value.
+	MinBound Bound
+	// MaxBound specifies whether the upper bound itself is an acceptable value.
+	MaxBound Bound
+	// empty specifies whether the bounds contradict each other, so that no value can fall within the range.
+	empty bool
+}
+
+// IsEmpty returns true if no value can fall within the range (i.e. the lower bound is greater than the upper bound).
+func (r *Range) IsEmpty() bool {
+	return r != nil && r.empty
+}
+
+// String returns the string representation of the range.
+//
+// The ranges with both bounds will be formatted using the interval notation (i.e. [1, 65535] or (0, 1]) and the
+// ranges with a single bound will be formatted using comparison operators (i.e. >= 1 or < 10).
+func (r *Range) String() string {
+	if r == nil {
+		return ""
+	}
+	switch {
+	case r.Min != "" && r.Max != "":
+		open, close := "[", "]"
+		if r.MinBound == Exclusive {
+			open = "("
+		}
+		if r.MaxBound == Exclusive {
+			close = ")"
+		}
+		return open + r.Min + ", " + r.Max + close
+	case r.Min != "":
+		if r.MinBound == Exclusive {
+			return "> " + r.Min
+		}
+		return ">= " + r.Min
+	case r.Max != "":
+		if r.MaxBound == Exclusive {
+			return "< " + r.Max
+		}
+		return "<= " + r.Max
+	default:
+		return ""
+	}
+}
+
+// RangeOf returns the bounds of the acceptable values of the flag.
+//
+// The method returns nil if the flag is unbounded or it does not implement RangeProvider interface.
+func RangeOf(f Flag) *Range {
+	if p, ok := f.(RangeProvider); ok {
+		return p.Range()
+	}
+	return nil
+}
+
+func boundOf(bound []Bound) Bound {
+	if len(bound) > 0 {
+		return bound[0]
+	}
+	return Inclusive
+}
+
+// intBounds holds the bounds of the signed integer and duration flags.
+type intBounds struct {
+	min, max           int64
+	hasMin, hasMax     bool
+	minBound, maxBound Bound
+}
+
+func (b *intBounds) setMin(min int64, bound []Bound) {
+	b.min, b.hasMin, b.minBound = min, true, boundOf(bound)
+}
+
+func (b *intBounds) setMax(max int64, bound []Bound) {
+	b.max, b.hasMax, b.maxBound = max, true, boundOf(bound)
+}
+
+func (b *intBounds) contains(v int64) bool {
+	if b.hasMin && (v < b.min || (v == b.min && b.minBound == Exclusive)) {
+		return false
+	}
+	if b.hasMax && (v > b.max || (v == b.max && b.maxBound == Exclusive)) {
+		return false
+	}
+	return true
+}
+
+// isEmpty returns true if the lower bound is greater than the upper bound, or they are equal and either one is exclusive.
+func (b *intBounds) isEmpty() bool {
+	if !b.hasMin || !b.hasMax {
+		return false
+	}
+	return b.min > b.max || (b.min == b.max && (b.minBound == Exclusive || b.maxBound == Exclusive))
+}
+
+func (b *intBounds) toRange(format func(int64) string) *Range {
+	if !b.hasMin && !b.hasMax {
+		return nil
+	}
+	r := &Range{MinBound: b.minBound, MaxBound: b.maxBound, empty: b.isEmpty()}
+	if b.hasMin {
+		r.Min = format(b.min)
+	}
+	if b.hasMax {
+		r.Max = format(b.max)
+	}
+	return r
+}
+
+// uintBounds holds the bounds of the unsigned integer flags.
+type uintBounds struct {
+	min, max           uint64
+	hasMin, hasMax     bool
+	minBound, maxBound Bound
+}
+
+func (b *uintBounds) setMin(min uint64, bound []Bound) {
+	b.min, b.hasMin, b.minBound = min, true, boundOf(bound)
+}
+
+func (b *uintBounds) setMax(max uint64, bound []Bound) {
+	b.max, b.hasMax, b.maxBound = max, true, boundOf(bound)
+}
+
+func (b *uintBounds) contains(v uint64) bool {
+	if b.hasMin && (v < b.min || (v == b.min && b.minBound == Exclusive)) {
+		return false
+	}
+	if b.hasMax && (v > b.max || (v == b.max && b.maxBound == Exclusive)) {
+		return false
+	}
+	return true
+}
+
+// isEmpty returns true if the lower bound is greater than the upper bound, or they are equal and either one is exclusive.
+func (b *uintBounds) isEmpty() bool {
+	if !b.hasMin || !b.hasMax {
+		return false
+	}
+	return b.min > b.max || (b.min == b.max && (b.minBound == Exclusive || b.maxBound == Exclusive))
+}
+
+func (b *uintBounds) toRange(format func(uint64) string) *Range {
+	if !b.hasMin && !b.hasMax {
+		return nil
+	}
+	r := &Range{MinBound: b.minBound, MaxBound: b.maxBound, empty: b.isEmpty()}
+	if b.hasMin {
+		r.Min = format(b.min)
+	}
+	if b.hasMax {
+		r.Max = format(b.max)
+	}
+	return r
+}
+
+// floatBounds holds the bounds of the floating point flags.
+type floatBounds struct {
+	min, max           float64
+	hasMin, hasMax     bool
+	minBound, maxBound Bound
+}
+
+func (b *floatBounds) setMin(min float64, bound []Bound) {
+	b.min, b.hasMin, b.minBound = min, true, boundOf(bound)
+}
+
+func (b *floatBounds) setMax(max float64, bound []Bound) {
+	b.max, b.hasMax, b.maxBound = max, true, boundOf(bound)
+}
+
+func (b *floatBounds) contains(v float64) bool {
+	if (b.hasMin || b.hasMax) && math.IsNaN(v) {
+		return false
+	}
+	if b.hasMin && (v < b.min || (v == b.min && b.minBound == Exclusive)) {
+		return false
+	}
+	if b.hasMax && (v > b.max || (v == b.max && b.maxBound == Exclusive)) {
+		return false
+	}
+	return true
+}
+
+// isEmpty returns true if the lower bound is greater than the upper bound, or they are equal and either one is exclusive.
+func (b *floatBounds) isEmpty() bool {
+	if !b.hasMin || !b.hasMax {
+		return false
+	}
+	return b.min > b.max || (b.min == b.max && (b.minBound == Exclusive || b.maxBound == Exclusive))
+}
+
+func (b *floatBounds) toRange(format func(float64) string) *Range {
+	if !b.hasMin && !b.hasMax {
+		return nil
+	}
+	r := &Range{MinBound: b.minBound, MaxBound: b.maxBound, empty: b.isEmpty()}
+	if b.hasMin {
+		r.Min = format(b.min)
+	}
+	if b.hasMax {
+		r.Max = format(b.max)
+	}
+	return r
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+func formatUInt(v uint64) string {
+	return strconv.FormatUint(v, 10)
+}
+
+func formatFloat32(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 32)
+}
+
+func formatFloat64(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatDuration(v int64) string {
+	return time.Duration(v).String()
+}
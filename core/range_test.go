@@ -0,0 +1,195 @@
+package core_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestRange_String(t *testing.T) {
+	testCases := []struct {
+		title    string
+		r        *core.Range
+		expected string
+	}{
+		{
+			title:    "nil range",
+			expected: "",
+		},
+		{
+			title:    "empty range",
+			r:        &core.Range{},
+			expected: "",
+		},
+		{
+			title:    "inclusive lower bound",
+			r:        &core.Range{Min: "1"},
+			expected: ">= 1",
+		},
+		{
+			title:    "exclusive lower bound",
+			r:        &core.Range{Min: "0", MinBound: core.Exclusive},
+			expected: "> 0",
+		},
+		{
+			title:    "inclusive upper bound",
+			r:        &core.Range{Max: "10"},
+			expected: "<= 10",
+		},
+		{
+			title:    "exclusive upper bound",
+			r:        &core.Range{Max: "10", MaxBound: core.Exclusive},
+			expected: "< 10",
+		},
+		{
+			title:    "closed range",
+			r:        &core.Range{Min: "1", Max: "65535"},
+			expected: "[1, 65535]",
+		},
+		{
+			title:    "open range",
+			r:        &core.Range{Min: "0", Max: "1", MinBound: core.Exclusive, MaxBound: core.Exclusive},
+			expected: "(0, 1)",
+		},
+		{
+			title:    "left-open range",
+			r:        &core.Range{Min: "0", Max: "1", MinBound: core.Exclusive},
+			expected: "(0, 1]",
+		},
+		{
+			title:    "right-open range",
+			r:        &core.Range{Min: "0", Max: "1", MaxBound: core.Exclusive},
+			expected: "[0, 1)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := tc.r.String()
+			if actual != tc.expected {
+				t.Errorf("Expected: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRangeOf(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     core.Flag
+		expected string
+	}{
+		{
+			title:    "flag without range support",
+			flag:     core.NewString("long", "usage"),
+			expected: "",
+		},
+		{
+			title:    "unbounded flag",
+			flag:     core.NewInt("long", "usage"),
+			expected: "",
+		},
+		{
+			title:    "signed integer flag",
+			flag:     core.NewInt8("long", "usage").WithBetween(math.MinInt8, -1),
+			expected: "[-128, -1]",
+		},
+		{
+			title:    "unsigned integer flag",
+			flag:     core.NewUInt64("long", "usage").WithMax(math.MaxUint64),
+			expected: "<= 18446744073709551615",
+		},
+		{
+			title:    "float32 flag",
+			flag:     core.NewFloat32("long", "usage").WithMin(0.1, core.Exclusive),
+			expected: "> 0.1",
+		},
+		{
+			title:    "counter flag",
+			flag:     core.NewCounter("long", "usage").WithMax(3),
+			expected: "<= 3",
+		},
+		{
+			title:    "duration flag",
+			flag:     core.NewDuration("long", "usage").WithBetween(time.Second, time.Minute+30*time.Second),
+			expected: "[1s, 1m30s]",
+		},
+		{
+			title:    "uint slice flag",
+			flag:     core.NewUIntSlice("long", "usage").WithMin(1),
+			expected: ">= 1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := core.RangeOf(tc.flag).String()
+			if actual != tc.expected {
+				t.Errorf("Expected: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRange_IsEmpty(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     core.Flag
+		expected bool
+	}{
+		{
+			title: "unbounded flag",
+			flag:  core.NewInt("long", "usage"),
+		},
+		{
+			title: "single bound",
+			flag:  core.NewInt("long", "usage").WithMin(10),
+		},
+		{
+			title: "valid bounds",
+			flag:  core.NewInt("long", "usage").WithBetween(1, 10),
+		},
+		{
+			title: "equal inclusive bounds",
+			flag:  core.NewUInt("long", "usage").WithBetween(1, 1),
+		},
+		{
+			title:    "equal bounds with an exclusive lower bound",
+			flag:     core.NewFloat64("long", "usage").WithMin(1, core.Exclusive).WithMax(1),
+			expected: true,
+		},
+		{
+			title:    "signed integer lower bound greater than the upper bound",
+			flag:     core.NewInt("long", "usage").WithBetween(10, 1),
+			expected: true,
+		},
+		{
+			title:    "unsigned integer lower bound greater than the upper bound",
+			flag:     core.NewUInt64("long", "usage").WithMin(10).WithMax(1),
+			expected: true,
+		},
+		{
+			title:    "duration lower bound greater than the upper bound",
+			flag:     core.NewDuration("long", "usage").WithBetween(time.Minute, time.Second),
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			if actual := core.RangeOf(tc.flag).IsEmpty(); actual != tc.expected {
+				t.Errorf("Expected IsEmpty(): %v, Actual: %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRange_NaN(t *testing.T) {
+	f := core.NewFloat64("long", "usage").WithMin(0)
+	err := f.Set("NaN")
+	if err == nil {
+		t.Error("Expected NaN to be out of range")
+	}
+}
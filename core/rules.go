@@ -0,0 +1,88 @@
+package core
+
+import (
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// stringRules holds the declarative validators of the string flags.
+type stringRules struct {
+	pattern  *regexp.Regexp
+	length   intBounds
+	nonEmpty bool
+	format   StringFormat
+}
+
+func (r *stringRules) check(value, long, short string) error {
+	name := internal.GetPrintName(long, short)
+	if r.nonEmpty && internal.IsEmpty(value) {
+		return internal.NewMessageErr(internal.MsgEmptyValue, name)
+	}
+	if !r.length.contains(int64(utf8.RuneCountInString(value))) {
+		return internal.NewMessageErr(internal.MsgLengthOutOfBounds, value, name, r.length.toRange(formatInt))
+	}
+	if r.pattern != nil && !r.pattern.MatchString(value) {
+		return internal.NewMessageErr(internal.MsgPatternMismatch, value, name, r.pattern)
+	}
+	if !r.format.Match(value) {
+		return internal.NewMessageErr(internal.MsgInvalidFormat, value, r.format, name)
+	}
+	return nil
+}
+
+func (r *stringRules) constraints() []Constraint {
+	constraints := make([]Constraint, 0)
+	if r.nonEmpty {
+		constraints = append(constraints, NewConstraint(MsgNonEmpty))
+	}
+	if length := r.length.toRange(formatInt); length != nil {
+		constraints = append(constraints, NewConstraint(MsgLength, length))
+	}
+	if r.pattern != nil {
+		constraints = append(constraints, NewConstraint(MsgPattern, r.pattern))
+	}
+	if r.format != AnyFormat {
+		constraints = append(constraints, NewConstraint(MsgFormat, r.format))
+	}
+	return constraints
+}
+
+// itemRules holds the declarative validators of the slice flags.
+type itemRules struct {
+	count  intBounds
+	unique bool
+}
+
+// check validates the number of the items and their uniqueness.
+//
+// The item function must return the string representation of the i-th item.
+func (r *itemRules) check(long, short string, count int, item func(i int) string) error {
+	name := internal.GetPrintName(long, short)
+	if !r.count.contains(int64(count)) {
+		return internal.NewMessageErr(internal.MsgItemCountOutOfBounds, count, name, r.count.toRange(formatInt))
+	}
+	if r.unique {
+		seen := make(map[string]interface{})
+		for i := 0; i < count; i++ {
+			v := item(i)
+			if _, ok := seen[v]; ok {
+				return internal.NewMessageErr(internal.MsgDuplicateItem, v, name)
+			}
+			seen[v] = nil
+		}
+	}
+	return nil
+}
+
+func (r *itemRules) constraints() []Constraint {
+	constraints := make([]Constraint, 0)
+	if count := r.count.toRange(formatInt); count != nil {
+		constraints = append(constraints, NewConstraint(MsgItems, count))
+	}
+	if r.unique {
+		constraints = append(constraints, NewConstraint(MsgUnique))
+	}
+	return constraints
+}
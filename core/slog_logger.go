@@ -0,0 +1,76 @@
+//go:build go1.21
+// +build go1.21
+
+package core
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogLogger represents a leveled logger which reports the events to a log/slog logger.
+//
+// The errors will be logged at error level and the deprecation warnings at warning level. The attributes of
+// the events will be converted to slog attributes.
+type SlogLogger struct {
+	logger *slog.Logger
+	level  Level
+}
+
+// NewSlogLogger creates a new leveled logger which reports the events to the slog logger.
+//
+// The slog.Default() logger will be used if the logger is nil. The minimum level is set to debug, which means the
+// handler of the slog logger decides which events must be logged, unless the level is raised using SetLevel.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{
+		logger: logger,
+		level:  LevelDebug,
+	}
+}
+
+// Print logs the error at error level, or at warning level if the error is a core.DeprecationWarning.
+func (l *SlogLogger) Print(err error) {
+	level := LevelError
+	if _, ok := err.(*DeprecationWarning); ok {
+		level = LevelWarn
+	}
+	l.Log(level, err.Error())
+}
+
+// Enabled returns true if the level is enabled by both the logger and the underlying slog handler.
+func (l *SlogLogger) Enabled(level Level) bool {
+	return level >= l.level && l.logger.Enabled(context.Background(), slogLevel(level))
+}
+
+// Log logs the event using the slog logger, if the level is enabled.
+func (l *SlogLogger) Log(level Level, msg string, attrs ...Attr) {
+	if level < l.level {
+		return
+	}
+	args := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		args[i] = slog.Any(attr.Key, attr.Value)
+	}
+	l.logger.LogAttrs(context.Background(), slogLevel(level), msg, args...)
+}
+
+// SetLevel sets the minimum level of the events which must be passed to the slog logger.
+func (l *SlogLogger) SetLevel(level Level) {
+	l.level = level
+}
+
+func slogLevel(level Level) slog.Level {
+	switch level {
+	case LevelDebug:
+		return slog.LevelDebug
+	case LevelWarn:
+		return slog.LevelWarn
+	case LevelError:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
@@ -0,0 +1,75 @@
+//go:build go1.21
+// +build go1.21
+
+package core_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	handler := slog.NewTextHandler(buf, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	logger := core.NewSlogLogger(slog.New(handler))
+
+	logger.Log(core.LevelDebug, "source queried", core.NewAttr("flag", "port"), core.NewAttr("found", true))
+	logger.Print(core.NewDeprecationWarning("old", "", nil))
+	logger.Print(errors.New("failed"))
+	logger.SetLevel(core.LevelInfo)
+	logger.Log(core.LevelDebug, "ignored")
+
+	expected := []string{
+		`level=DEBUG msg="source queried" flag=port found=true`,
+		`level=WARN msg="--old is deprecated."`,
+		`level=ERROR msg=failed`,
+	}
+	actual := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(actual) != len(expected) {
+		t.Fatalf("Expected %d lines, Actual: %q", len(expected), actual)
+	}
+	for i, line := range expected {
+		if actual[i] != line {
+			t.Errorf("Expected %q, Actual: %q", line, actual[i])
+		}
+	}
+
+	if logger.Enabled(core.LevelDebug) {
+		t.Errorf("Expected the debug level to be disabled")
+	}
+	if !logger.Enabled(core.LevelInfo) {
+		t.Errorf("Expected the info level to be enabled")
+	}
+}
+
+func TestProvenance_LogValue(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := core.NewSlogLogger(slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	provenance := &core.Provenance{
+		Origin: core.CommandLine,
+		Name:   "--token",
+		Value:  "secret",
+	}
+	logger.Log(core.LevelDebug, "value resolved", core.NewAttr("origin", provenance))
+
+	actual := buf.String()
+	if !strings.Contains(actual, `origin.origin="command line" origin.name=--token`) {
+		t.Errorf("Expected the origin and the name of the provenance to be logged, Actual: %s", actual)
+	}
+	if strings.Contains(actual, "secret") {
+		t.Errorf("Did not expect the value of the provenance to be logged, Actual: %s", actual)
+	}
+}
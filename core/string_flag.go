@@ -1,6 +1,7 @@
 package core
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/xitonix/flags/internal"
@@ -15,13 +16,18 @@ type StringFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	validate            func(in string) error
 	validationList      map[string]interface{}
 	acceptableItems     []string
 	ignoreCase          bool
+	rules               stringRules
+	validators          []Validator
 }
 
 // NewString creates a new string flag.
@@ -60,7 +66,7 @@ func (f *StringFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *StringFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -145,21 +151,67 @@ func (f *StringFlag) Hide() *StringFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *StringFlag) WithGroup(name string) *StringFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *StringFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *StringFlag) WithDescription(description string) *StringFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *StringFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *StringFlag) WithExamples(examples ...string) *StringFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *StringFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *StringFlag) MarkAsDeprecated() *StringFlag {
-	f.isDeprecated = true
+func (f *StringFlag) MarkAsDeprecated(options ...DeprecationOption) *StringFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *StringFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
 //
 // The set operation will fail if the callback returns an error.
@@ -170,6 +222,15 @@ func (f *StringFlag) WithValidationCallback(validate func(in string) error) *Str
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *StringFlag) WithValidators(validators ...Validator) *StringFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -195,8 +256,62 @@ func (f *StringFlag) WithValidRange(ignoreCase bool, valid ...string) *StringFla
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *StringFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithPattern sets the regular expression which the value must match.
+//
+// Remember that setting the validators will have no effect if a validation callback has been specified.
+func (f *StringFlag) WithPattern(pattern *regexp.Regexp) *StringFlag {
+	f.rules.pattern = pattern
+	return f
+}
+
+// WithMinLength sets the minimum number of characters of the value (inclusive).
+func (f *StringFlag) WithMinLength(min int) *StringFlag {
+	f.rules.length.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxLength sets the maximum number of characters of the value (inclusive).
+func (f *StringFlag) WithMaxLength(max int) *StringFlag {
+	f.rules.length.setMax(int64(max), nil)
+	return f
+}
+
+// NonEmpty rejects the empty or white space only values.
+func (f *StringFlag) NonEmpty() *StringFlag {
+	f.rules.nonEmpty = true
+	return f
+}
+
+// WithFormat sets the well-known format of the value (i.e. core.EmailFormat).
+func (f *StringFlag) WithFormat(format StringFormat) *StringFlag {
+	f.rules.format = format
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *StringFlag) Constraints() []Constraint {
+	return append(f.rules.constraints(), describeValidators(f.validators)...)
+}
+
 // Set sets the flag value.
 func (f *StringFlag) Set(value string) error {
+	if err := f.validateValue(value); err != nil {
+		return err
+	}
+	f.set(value)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+func (f *StringFlag) validateValue(value string) error {
 	if f.validate != nil {
 		err := f.validate(value)
 		if err != nil {
@@ -204,6 +319,10 @@ func (f *StringFlag) Set(value string) error {
 		}
 	}
 
+	if err := applyValidators(value, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
 	// Validation callback takes priority over validation list
 	if f.validate == nil && len(f.validationList) > 0 {
 		item := value
@@ -217,8 +336,11 @@ func (f *StringFlag) Set(value string) error {
 			return internal.OutOfRangeErr(value, f.long, f.short, f.acceptableItems)
 		}
 	}
-	f.set(value)
-	f.isSet = true
+	if f.validate == nil {
+		if err := f.rules.check(value, f.long, f.short); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -247,6 +369,14 @@ func (f *StringFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *StringFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -2,9 +2,11 @@ package core_test
 
 import (
 	"errors"
+	"regexp"
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestString(t *testing.T) {
@@ -521,6 +523,90 @@ func TestStringFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestStringFlag_Validators(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.StringFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title:         "no validators",
+			flag:          flags.String("long", "usage"),
+			value:         " ",
+			expectedValue: " ",
+		},
+		{
+			title:         "non-empty value",
+			flag:          flags.String("long", "usage").NonEmpty(),
+			value:         "value",
+			expectedValue: "value",
+		},
+		{
+			title:         "white space value for a non-empty flag",
+			flag:          flags.String("long", "usage").WithShort("l").NonEmpty(),
+			value:         "  ",
+			expectedError: "-l, --long cannot be empty.",
+		},
+		{
+			title:         "value shorter than the minimum length",
+			flag:          flags.String("long", "usage").WithMinLength(3),
+			value:         "ab",
+			expectedError: "'ab' is not an acceptable value for --long. The expected length is >= 3.",
+		},
+		{
+			title:         "value longer than the maximum length",
+			flag:          flags.String("long", "usage").WithMinLength(1).WithMaxLength(3),
+			value:         "abcd",
+			expectedError: "'abcd' is not an acceptable value for --long. The expected length is [1, 3].",
+		},
+		{
+			title:         "multi-byte characters within the maximum length",
+			flag:          flags.String("long", "usage").WithMaxLength(3),
+			value:         "äöü",
+			expectedValue: "äöü",
+		},
+		{
+			title:         "value matching the pattern",
+			flag:          flags.String("long", "usage").WithPattern(regexp.MustCompile(`^v\d+$`)),
+			value:         "v2",
+			expectedValue: "v2",
+		},
+		{
+			title:         "value not matching the pattern",
+			flag:          flags.String("long", "usage").WithPattern(regexp.MustCompile(`^v\d+$`)),
+			value:         "version2",
+			expectedError: `'version2' is not an acceptable value for --long. The value must match the ^v\d+$ pattern.`,
+		},
+		{
+			title:         "value in the expected format",
+			flag:          flags.String("long", "usage").WithFormat(core.EmailFormat),
+			value:         "user@example.com",
+			expectedValue: "user@example.com",
+		},
+		{
+			title:         "value in an invalid format",
+			flag:          flags.String("long", "usage").WithFormat(core.UUIDFormat),
+			value:         "not-a-uuid",
+			expectedError: "'not-a-uuid' is not a valid UUID for --long.",
+		},
+		{
+			title:         "validation callback takes priority over the validators",
+			flag:          flags.String("long", "usage").NonEmpty().WithValidationCallback(func(in string) error { return nil }),
+			value:         "",
+			expectedValue: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
 func TestStringFlag_ResetToDefault(t *testing.T) {
 	testCases := []struct {
 		title                   string
@@ -0,0 +1,75 @@
+package core
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// StringFormat represents a well-known format of string values.
+type StringFormat int8
+
+const (
+	// AnyFormat any string value is acceptable.
+	AnyFormat StringFormat = iota
+	// HostnameFormat a host name as defined by RFC 1123 (i.e. api.example.com).
+	HostnameFormat
+	// EmailFormat an email address without a display name (i.e. user@example.com).
+	EmailFormat
+	// UUIDFormat a UUID in its canonical textual representation (i.e. 123e4567-e89b-12d3-a456-426614174000).
+	UUIDFormat
+	// IdentifierFormat a letter or an underscore, followed by any number of letters, digits or underscores (i.e. max_size).
+	IdentifierFormat
+)
+
+var (
+	hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	uuidPattern   = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	identifier    = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// String returns the human-readable name of the format.
+func (f StringFormat) String() string {
+	switch f {
+	case HostnameFormat:
+		return "hostname"
+	case EmailFormat:
+		return "email address"
+	case UUIDFormat:
+		return "UUID"
+	case IdentifierFormat:
+		return "identifier"
+	default:
+		return "string"
+	}
+}
+
+// Match returns true if the value is in the expected format.
+func (f StringFormat) Match(value string) bool {
+	switch f {
+	case HostnameFormat:
+		return isHostname(value)
+	case EmailFormat:
+		address, err := mail.ParseAddress(value)
+		return err == nil && address.Address == value
+	case UUIDFormat:
+		return uuidPattern.MatchString(value)
+	case IdentifierFormat:
+		return identifier.MatchString(value)
+	default:
+		return true
+	}
+}
+
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if len(value) == 0 || len(value) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(value, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
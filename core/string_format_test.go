@@ -0,0 +1,114 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestStringFormat_Match(t *testing.T) {
+	testCases := []struct {
+		title    string
+		format   core.StringFormat
+		value    string
+		expected bool
+	}{
+		{
+			title:    "any format",
+			format:   core.AnyFormat,
+			value:    "",
+			expected: true,
+		},
+		{
+			title:    "single label hostname",
+			format:   core.HostnameFormat,
+			value:    "localhost",
+			expected: true,
+		},
+		{
+			title:    "fully qualified hostname",
+			format:   core.HostnameFormat,
+			value:    "api-1.example.com.",
+			expected: true,
+		},
+		{
+			title:  "hostname with leading hyphen",
+			format: core.HostnameFormat,
+			value:  "-api.example.com",
+		},
+		{
+			title:  "hostname with empty label",
+			format: core.HostnameFormat,
+			value:  "api..example.com",
+		},
+		{
+			title:  "hostname with a label longer than 63 characters",
+			format: core.HostnameFormat,
+			value:  strings.Repeat("a", 64) + ".com",
+		},
+		{
+			title:  "hostname with underscore",
+			format: core.HostnameFormat,
+			value:  "my_host",
+		},
+		{
+			title:    "email address",
+			format:   core.EmailFormat,
+			value:    "user.name+tag@example.com",
+			expected: true,
+		},
+		{
+			title:  "email address with display name",
+			format: core.EmailFormat,
+			value:  "User <user@example.com>",
+		},
+		{
+			title:  "email address without domain",
+			format: core.EmailFormat,
+			value:  "user",
+		},
+		{
+			title:    "lower case UUID",
+			format:   core.UUIDFormat,
+			value:    "123e4567-e89b-12d3-a456-426614174000",
+			expected: true,
+		},
+		{
+			title:    "upper case UUID",
+			format:   core.UUIDFormat,
+			value:    "123E4567-E89B-12D3-A456-426614174000",
+			expected: true,
+		},
+		{
+			title:  "UUID without hyphens",
+			format: core.UUIDFormat,
+			value:  "123e4567e89b12d3a456426614174000",
+		},
+		{
+			title:    "identifier",
+			format:   core.IdentifierFormat,
+			value:    "_max_size2",
+			expected: true,
+		},
+		{
+			title:  "identifier starting with a digit",
+			format: core.IdentifierFormat,
+			value:  "2max",
+		},
+		{
+			title:  "identifier with hyphen",
+			format: core.IdentifierFormat,
+			value:  "max-size",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := tc.format.Match(tc.value)
+			if actual != tc.expected {
+				t.Errorf("Expected %s match for '%s': %v, Actual: %v", tc.format, tc.value, tc.expected, actual)
+			}
+		})
+	}
+}
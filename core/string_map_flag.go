@@ -24,13 +24,17 @@ type StringMapFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	trimKey             bool
 	trimValue           bool
 	delimiter           string
 	validate            func(key, value string) error
+	validators          []Validator
 }
 
 // NewStringMap creates a new string map flag.
@@ -72,7 +76,7 @@ func (f *StringMapFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *StringMapFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // Type returns the string representation of the flag's type.
@@ -144,21 +148,67 @@ func (f *StringMapFlag) Hide() *StringMapFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *StringMapFlag) WithGroup(name string) *StringMapFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *StringMapFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *StringMapFlag) WithDescription(description string) *StringMapFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *StringMapFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *StringMapFlag) WithExamples(examples ...string) *StringMapFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *StringMapFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *StringMapFlag) MarkAsDeprecated() *StringMapFlag {
-	f.isDeprecated = true
+func (f *StringMapFlag) MarkAsDeprecated(options ...DeprecationOption) *StringMapFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *StringMapFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // IsRequired returns true if the flag value must be provided.
 func (f *StringMapFlag) IsRequired() bool {
 	return f.isRequired
@@ -180,6 +230,21 @@ func (f *StringMapFlag) WithValidationCallback(validate func(key, value string)
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to the value of each key-value pair.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *StringMapFlag) WithValidators(validators ...Validator) *StringMapFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the descriptions of the validators of the flag.
+func (f *StringMapFlag) Constraints() []Constraint {
+	return describeValidators(f.validators)
+}
+
 // Set sets the flag value.
 //
 // The value of a string map flag can be set using map initialisation literals.
@@ -213,6 +278,17 @@ func (f *StringMapFlag) Set(value string) error {
 		mp[parts[0]] = parts[1]
 	}
 
+	if err := f.validateValue(mp); err != nil {
+		return err
+	}
+
+	f.set(mp)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the keys and the values of the map against the validation rules of the flag.
+func (f *StringMapFlag) validateValue(mp map[string]string) error {
 	if f.validate != nil {
 		for k, v := range mp {
 			if err := f.validate(k, v); err != nil {
@@ -221,8 +297,12 @@ func (f *StringMapFlag) Set(value string) error {
 		}
 	}
 
-	f.set(mp)
-	f.isSet = true
+	for _, v := range mp {
+		if err := applyValidators(v, f.validators, f.long, f.short); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -269,6 +349,14 @@ func (f *StringMapFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *StringMapFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/xitonix/flags/internal"
@@ -25,15 +26,21 @@ type StringSliceFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	delimiter           string
 	trimSpaces          bool
 	validate            func(in string) error
 	validationList      map[string]interface{}
 	acceptableItems     []string
 	ignoreCase          bool
+	rules               stringRules
+	items               itemRules
+	validators          []Validator
 }
 
 // NewStringSlice creates a new string slice flag.
@@ -74,7 +81,7 @@ func (f *StringSliceFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *StringSliceFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -159,21 +166,67 @@ func (f *StringSliceFlag) Hide() *StringSliceFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *StringSliceFlag) WithGroup(name string) *StringSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *StringSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *StringSliceFlag) WithDescription(description string) *StringSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *StringSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *StringSliceFlag) WithExamples(examples ...string) *StringSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *StringSliceFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *StringSliceFlag) MarkAsDeprecated() *StringSliceFlag {
-	f.isDeprecated = true
+func (f *StringSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *StringSliceFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *StringSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
 func (f *StringSliceFlag) WithDelimiter(delimiter string) *StringSliceFlag {
 	if len(delimiter) == 0 {
@@ -199,6 +252,16 @@ func (f *StringSliceFlag) WithValidationCallback(validate func(in string) error)
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *StringSliceFlag) WithValidators(validators ...Validator) *StringSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -224,6 +287,70 @@ func (f *StringSliceFlag) WithValidRange(ignoreCase bool, valid ...string) *Stri
 	return f
 }
 
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *StringSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithPattern sets the regular expression which the items must match.
+//
+// Remember that setting the validators will have no effect if a validation callback has been specified.
+func (f *StringSliceFlag) WithPattern(pattern *regexp.Regexp) *StringSliceFlag {
+	f.rules.pattern = pattern
+	return f
+}
+
+// WithMinLength sets the minimum number of characters of the items (inclusive).
+func (f *StringSliceFlag) WithMinLength(min int) *StringSliceFlag {
+	f.rules.length.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxLength sets the maximum number of characters of the items (inclusive).
+func (f *StringSliceFlag) WithMaxLength(max int) *StringSliceFlag {
+	f.rules.length.setMax(int64(max), nil)
+	return f
+}
+
+// NonEmpty rejects the empty or white space only items.
+//
+// Use WithMinItems(1) to reject the empty lists.
+func (f *StringSliceFlag) NonEmpty() *StringSliceFlag {
+	f.rules.nonEmpty = true
+	return f
+}
+
+// WithFormat sets the well-known format of the items (i.e. core.EmailFormat).
+func (f *StringSliceFlag) WithFormat(format StringFormat) *StringSliceFlag {
+	f.rules.format = format
+	return f
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *StringSliceFlag) WithMinItems(min int) *StringSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *StringSliceFlag) WithMaxItems(max int) *StringSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *StringSliceFlag) Unique() *StringSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *StringSliceFlag) Constraints() []Constraint {
+	return append(append(f.rules.constraints(), f.items.constraints()...), describeValidators(f.validators)...)
+}
+
 // Set sets the flag value.
 //
 // The value of a string slice flag can be set using comma (or any custom delimiter) separated strings.
@@ -243,6 +370,17 @@ func (f *StringSliceFlag) Set(value string) error {
 		parts = strings.Split(value, f.delimiter)
 	}
 
+	if err := f.validateItems(parts); err != nil {
+		return err
+	}
+
+	f.set(parts)
+	f.isSet = true
+	return nil
+}
+
+// validateItems checks the items against the validation rules of the flag.
+func (f *StringSliceFlag) validateItems(parts []string) error {
 	if f.validate != nil {
 		for _, item := range parts {
 			err := f.validate(item)
@@ -268,9 +406,26 @@ func (f *StringSliceFlag) Set(value string) error {
 		}
 	}
 
-	f.set(parts)
-	f.isSet = true
-	return nil
+	for _, item := range parts {
+		if f.trimSpaces {
+			item = strings.TrimSpace(item)
+		}
+		if f.validate == nil {
+			if err := f.rules.check(item, f.long, f.short); err != nil {
+				return err
+			}
+		}
+		if err := applyValidators(item, f.validators, f.long, f.short); err != nil {
+			return err
+		}
+	}
+
+	return f.items.check(f.long, f.short, len(parts), func(i int) string {
+		if f.trimSpaces {
+			return strings.TrimSpace(parts[i])
+		}
+		return parts[i]
+	})
 }
 
 // ResetToDefault resets the value of this flag to default if a default value is specified.
@@ -295,6 +450,14 @@ func (f *StringSliceFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *StringSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateItems(f.defaultValue)
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
@@ -3,9 +3,12 @@ package core_test
 import (
 	"errors"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/validate"
 )
 
 func TestStringSlice(t *testing.T) {
@@ -652,6 +655,103 @@ func TestStringSliceFlag_Validation(t *testing.T) {
 	}
 }
 
+func TestStringSliceFlag_Validators(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.StringSliceFlag
+		value         string
+		expectedValue []string
+		expectedError string
+	}{
+		{
+			title:         "no validators",
+			flag:          flags.StringSlice("long", "usage"),
+			value:         "a,a,",
+			expectedValue: []string{"a", "a", ""},
+		},
+		{
+			title:         "empty item for a non-empty flag",
+			flag:          flags.StringSlice("long", "usage").NonEmpty(),
+			value:         "a, ,b",
+			expectedValue: []string{},
+			expectedError: "--long cannot be empty.",
+		},
+		{
+			title:         "trimmed items within the maximum length",
+			flag:          flags.StringSlice("long", "usage").WithMaxLength(1),
+			value:         "a, b",
+			expectedValue: []string{"a", "b"},
+		},
+		{
+			title:         "item not matching the pattern",
+			flag:          flags.StringSlice("long", "usage").WithPattern(regexp.MustCompile(`^[a-z]+$`)),
+			value:         "abc,ABC",
+			expectedValue: []string{},
+			expectedError: "'ABC' is not an acceptable value for --long. The value must match the ^[a-z]+$ pattern.",
+		},
+		{
+			title:         "item in an invalid format",
+			flag:          flags.StringSlice("long", "usage").WithFormat(core.HostnameFormat),
+			value:         "example.com,-invalid",
+			expectedValue: []string{},
+			expectedError: "'-invalid' is not a valid hostname for --long.",
+		},
+		{
+			title:         "less items than the minimum",
+			flag:          flags.StringSlice("long", "usage").WithMinItems(1),
+			value:         "",
+			expectedValue: []string{},
+			expectedError: "0 items are not acceptable for --long. The expected number of items is >= 1.",
+		},
+		{
+			title:         "more items than the maximum",
+			flag:          flags.StringSlice("long", "usage").WithMinItems(1).WithMaxItems(2),
+			value:         "a,b,c",
+			expectedValue: []string{},
+			expectedError: "3 items are not acceptable for --long. The expected number of items is [1, 2].",
+		},
+		{
+			title:         "unique items",
+			flag:          flags.StringSlice("long", "usage").Unique(),
+			value:         "a,A",
+			expectedValue: []string{"a", "A"},
+		},
+		{
+			title:         "duplicate trimmed items",
+			flag:          flags.StringSlice("long", "usage").Unique(),
+			value:         "a, b,b ",
+			expectedValue: []string{},
+			expectedError: "'b' has been provided more than once for --long. The items must be unique.",
+		},
+		{
+			title:         "duplicate items with trimming disabled",
+			flag:          flags.StringSlice("long", "usage").DisableTrimming().Unique(),
+			value:         "b, b",
+			expectedValue: []string{"b", " b"},
+		},
+		{
+			title:         "items accepted by the reusable validators",
+			flag:          flags.StringSlice("long", "usage").WithValidators(validate.OneOf("json", "yaml")),
+			value:         "json, yaml",
+			expectedValue: []string{"json", "yaml"},
+		},
+		{
+			title:         "item rejected by the reusable validators",
+			flag:          flags.StringSlice("long", "usage").WithValidators(validate.OneOf("json", "yaml")),
+			value:         "json, xml",
+			expectedValue: []string{},
+			expectedError: "xml is not an acceptable value for --long: the expected values are json, yaml.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkSliceFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
 func TestStringSliceFlag_ResetToDefault(t *testing.T) {
 	empty := make([]string, 0)
 	testCases := []struct {
@@ -2,36 +2,249 @@ package core
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/xitonix/flags/internal"
 )
 
 // TabbedHelpFormatter represents a tab separated help formatter.
-type TabbedHelpFormatter struct{}
+//
+// The keys will be rendered as environment variables (i.e. [$FILE_PATH]). The key column will be omitted
+// entirely, if none of the flags has a key.
+type TabbedHelpFormatter struct {
+	theme        *Theme
+	keyFormat    *KeyFormat
+	revealHidden bool
+	messages     MessageCatalogue
+}
+
+// SetTheme sets the theme of the help output. A nil theme disables styling.
+func (t *TabbedHelpFormatter) SetTheme(theme *Theme) {
+	t.theme = theme
+}
+
+// SetKeyFormat sets the settings which control how the flag keys are rendered in the help output.
+func (t *TabbedHelpFormatter) SetKeyFormat(format *KeyFormat) {
+	t.keyFormat = format
+}
+
+// SetMessageCatalogue sets the catalogue which will be used to translate the headings and labels.
+func (t *TabbedHelpFormatter) SetMessageCatalogue(catalogue MessageCatalogue) {
+	t.messages = catalogue
+}
+
+// RevealHidden sets whether the hidden flags must be rendered.
+func (t *TabbedHelpFormatter) RevealHidden(reveal bool) {
+	t.revealHidden = reveal
+}
 
 // Format returns a tab separated help string for the flag.
 func (t *TabbedHelpFormatter) Format(f Flag, deprecationMark, defaultValueFormatString, requiredMark string) string {
-	if f.IsHidden() {
+	if f.IsHidden() && !t.revealHidden {
 		return ""
 	}
+	theme := t.style()
 	short := f.ShortName()
 	if !internal.IsEmpty(short) {
 		short = "-" + short + ","
 	}
+	// The empty cells must also be styled to keep the number of invisible characters in each column the same.
+	short = theme.FlagName.Apply(short)
+	long := theme.FlagName.Apply("--" + f.LongName())
 	var def string
 	if dv := f.Default(); dv != nil && !internal.IsEmpty(defaultValueFormatString) {
 		def = fmt.Sprintf(" "+defaultValueFormatString, dv)
 	}
 
+	var bounds string
+	if r := RangeOf(f); r != nil {
+		bounds = " (" + t.msg(MsgRange, r) + ")"
+	}
+	if constraints := ConstraintsOf(f); len(constraints) > 0 {
+		bounds += " (" + formatConstraints(constraints, t.messages) + ")"
+	}
+
 	var dep string
 	if f.IsDeprecated() && !internal.IsEmpty(deprecationMark) {
-		dep = " " + deprecationMark
+		dep = " " + theme.DeprecationMark.Apply(deprecationMark)
 	}
 
 	var required string
 	if f.IsRequired() {
 		required = requiredMark
 	}
+	required = theme.RequiredMark.Apply(required)
+
+	// The key cell is terminated by a vertical tab, so that the column gets discarded if all the keys are empty.
+	return fmt.Sprintf("%s\t%s\t%s\v%s%s\t\t\t%s%s%s%s\n", short, long, formatKey(t.keyFormat, f.Key().String()), theme.Type.Apply(f.Type()), required, f.Usage(), bounds, def, dep)
+}
+
+// FormatHeader returns the usage line and the description of the running tool followed by the flags title.
+//
+// The flags title will not be printed if the flags are grouped.
+func (t *TabbedHelpFormatter) FormatHeader(sections *HelpSections) string {
+	if sections == nil {
+		return ""
+	}
+	theme := t.style()
+	sb := &strings.Builder{}
+	if !internal.IsEmpty(sections.Usage) {
+		sb.WriteString(theme.Heading.Apply(t.msg(MsgUsageHeading)) + " " + strings.TrimSpace(sections.Usage) + "\n\n")
+	}
+	if !internal.IsEmpty(sections.Description) {
+		sb.WriteString(strings.TrimSpace(sections.Description) + "\n\n")
+	}
+	if sb.Len() == 0 {
+		return ""
+	}
+	if !sections.Grouped {
+		sb.WriteString(theme.Heading.Apply(t.msg(MsgFlagsHeading)) + "\n")
+	}
+	return sb.String()
+}
+
+// FormatFooter returns the usage examples and the epilogue of the running tool.
+func (t *TabbedHelpFormatter) FormatFooter(sections *HelpSections) string {
+	if sections == nil {
+		return ""
+	}
+	theme := t.style()
+	sb := &strings.Builder{}
+	if len(sections.Examples) > 0 {
+		sb.WriteString("\n" + theme.Heading.Apply(t.msg(MsgExamplesHeading)) + "\n")
+		for _, example := range sections.Examples {
+			sb.WriteString("  " + example.Command + "\n")
+			if !internal.IsEmpty(example.Description) {
+				sb.WriteString("      " + strings.TrimSpace(example.Description) + "\n")
+			}
+		}
+	}
+	if !internal.IsEmpty(sections.Footer) {
+		sb.WriteString("\n" + strings.TrimSpace(sections.Footer) + "\n")
+	}
+	return sb.String()
+}
+
+// FormatGroup returns the heading of a flag group.
+func (t *TabbedHelpFormatter) FormatGroup(name string, index int) string {
+	heading := t.style().Heading.Apply(name+":") + "\n"
+	if index > 0 {
+		return "\n" + heading
+	}
+	return heading
+}
+
+// FormatDetails returns the help page of a single flag.
+//
+// The page includes the usage string, long description and the examples of the flag, followed by its key,
+// default value, valid values, status and the value to which the flag resolves.
+func (t *TabbedHelpFormatter) FormatDetails(details *FlagDetails, deprecationMark, defaultValueFormatString, requiredMark string) string {
+	if details == nil || details.Flag == nil {
+		return ""
+	}
+	f := details.Flag
+	theme := t.style()
+	sb := &strings.Builder{}
+
+	name := theme.FlagName.Apply("--" + f.LongName())
+	if short := f.ShortName(); !internal.IsEmpty(short) {
+		name = theme.FlagName.Apply("-"+short+",") + " " + name
+	}
+	var required string
+	if f.IsRequired() {
+		required = theme.RequiredMark.Apply(requiredMark)
+	}
+	sb.WriteString(name + " " + theme.Type.Apply(f.Type()) + required + "\n")
+	if usage := strings.TrimSpace(f.Usage()); !internal.IsEmpty(usage) {
+		sb.WriteString("  " + usage + "\n")
+	}
+	if description := DescriptionOf(f); !internal.IsEmpty(description) {
+		for _, line := range strings.Split(description, "\n") {
+			line = strings.TrimSpace(line)
+			if internal.IsEmpty(line) {
+				sb.WriteString("\n")
+				continue
+			}
+			sb.WriteString("  " + line + "\n")
+		}
+	}
+
+	if examples := ExamplesOf(f); len(examples) > 0 {
+		sb.WriteString("\n" + theme.Heading.Apply(t.msg(MsgExamplesHeading)) + "\n")
+		for _, example := range examples {
+			sb.WriteString("  " + strings.TrimSpace(example) + "\n")
+		}
+	}
+
+	sb.WriteString("\n")
+	if key := formatKey(t.keyFormat, f.Key().String()); !internal.IsEmpty(key) {
+		sb.WriteString(t.msg(MsgEnvironmentLabel) + "\t" + key + "\n")
+	}
+	if dv := f.Default(); dv != nil {
+		sb.WriteString(t.msg(MsgDefaultLabel) + "\t" + FormatValue(dv) + "\n")
+	}
+	if p, ok := f.(ValidRangeProvider); ok && len(p.ValidRange()) > 0 {
+		sb.WriteString(t.msg(MsgValidValuesLabel) + "\t" + strings.Join(p.ValidRange(), ", ") + "\n")
+	}
+	if r := RangeOf(f); r != nil {
+		sb.WriteString(t.msg(MsgRangeLabel) + "\t" + r.String() + "\n")
+	}
+	if constraints := ConstraintsOf(f); len(constraints) > 0 {
+		sb.WriteString(t.msg(MsgConstraintsLabel) + "\t" + formatConstraints(constraints, t.messages) + "\n")
+	}
+	if group := GroupOf(f); !internal.IsEmpty(group) {
+		sb.WriteString(t.msg(MsgGroupLabel) + "\t" + group + "\n")
+	}
+	if status := flagStatus(f, t.messages); len(status) > 0 {
+		sb.WriteString(t.msg(MsgStatusLabel) + "\t" + strings.Join(status, ", ") + "\n")
+	}
+	if f.IsDeprecated() {
+		sb.WriteString(t.msg(MsgDeprecatedLabel) + "\t" + deprecationNote(f, theme.DeprecationMark.Apply(deprecationMark), t.messages) + "\n")
+	}
+	sb.WriteString(t.msg(MsgCurrentValueLabel) + "\t" + details.Value + "\n")
+	sb.WriteString(t.msg(MsgSourceLabel) + "\t" + details.Provenance.Format(t.messages) + "\n")
+	return sb.String()
+}
+
+// msg returns the translated text of the message.
+func (t *TabbedHelpFormatter) msg(id string, args ...interface{}) string {
+	return messageOf(t.messages, id, args...)
+}
+
+// style returns the current theme or an empty theme if styling has been disabled.
+func (t *TabbedHelpFormatter) style() *Theme {
+	if t.theme == nil {
+		return &Theme{}
+	}
+	return t.theme
+}
 
-	return fmt.Sprintf("%s\t--%s\t%s\t%s%s\t\t\t%s%s%s\n", short, f.LongName(), f.Key(), f.Type(), required, f.Usage(), def, dep)
+// flagStatus returns the list of the flag's status labels (i.e. required).
+func flagStatus(f Flag, catalogue MessageCatalogue) []string {
+	status := make([]string, 0)
+	if f.IsRequired() {
+		status = append(status, messageOf(catalogue, MsgStatusRequired))
+	}
+	if f.IsDeprecated() {
+		status = append(status, messageOf(catalogue, MsgStatusDeprecated))
+	}
+	if f.IsHidden() {
+		status = append(status, messageOf(catalogue, MsgStatusHidden))
+	}
+	return status
+}
+
+// deprecationNote returns the deprecation mark of the flag followed by the deprecation message and the replacement.
+func deprecationNote(f Flag, deprecationMark string, catalogue MessageCatalogue) string {
+	note := deprecationMark
+	if p, ok := f.(DeprecationProvider); ok && p.Deprecation() != nil {
+		d := p.Deprecation()
+		if !internal.IsEmpty(d.Message) {
+			note += " " + d.Message
+		}
+		if !internal.IsEmpty(d.Replacement) {
+			note += " " + messageOf(catalogue, MsgUseReplacement, d.Replacement)
+		}
+	}
+	return strings.TrimSpace(note)
 }
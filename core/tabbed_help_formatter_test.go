@@ -1,11 +1,14 @@
 package core_test
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/xitonix/flags/core"
 	"github.com/xitonix/flags/mocks"
+	"github.com/xitonix/flags/validate"
 )
 
 func TestTabbedHelpFormatter_Format(t *testing.T) {
@@ -30,22 +33,22 @@ func TestTabbedHelpFormatter_Format(t *testing.T) {
 		},
 		{
 			title:    "with long name and usage only",
-			expected: fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
+			expected: fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
 			flag:     mocks.NewFlagWithUsage("long", "", "usage"),
 		},
 		{
 			title:    "with long and short names and empty usage",
-			expected: fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "-s,", "long", "", "generic", "", "", ""),
+			expected: fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "-s,", "long", "", "generic", "", "", ""),
 			flag:     mocks.NewFlagWithUsage("long", "s", ""),
 		},
 		{
 			title:    "with long and short names along with usage",
-			expected: fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "-s,", "long", "", "generic", "usage", "", ""),
+			expected: fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "-s,", "long", "", "generic", "usage", "", ""),
 			flag:     mocks.NewFlagWithUsage("long", "s", "usage"),
 		},
 		{
 			title:                    "with long name and default value along with usage",
-			expected:                 fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", " [DEFAULT: default]", ""),
+			expected:                 fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", " [DEFAULT: default]", ""),
 			defaultValue:             "default",
 			setDefault:               true,
 			defaultValueFormatString: "[DEFAULT: %v]",
@@ -53,33 +56,33 @@ func TestTabbedHelpFormatter_Format(t *testing.T) {
 		},
 		{
 			title:        "without default value indicator",
-			expected:     fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
+			expected:     fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
 			defaultValue: "default",
 			setDefault:   true,
 			flag:         mocks.NewFlagWithUsage("long", "", "usage"),
 		},
 		{
 			title:                  "deprecated with long name and usage",
-			expected:               fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", " DEP"),
+			expected:               fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", " DEP"),
 			deprecatedFormatString: "DEP",
 			isDeprecated:           true,
 			flag:                   mocks.NewFlagWithUsage("long", "", "usage"),
 		},
 		{
 			title:        "without deprecated indicator",
-			expected:     fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
+			expected:     fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
 			isDeprecated: true,
 			flag:         mocks.NewFlagWithUsage("long", "", "usage"),
 		},
 		{
 			title:      "required without a mark",
-			expected:   fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
+			expected:   fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic", "usage", "", ""),
 			isRequired: true,
 			flag:       mocks.NewFlagWithUsage("long", "", "usage"),
 		},
 		{
 			title:        "required with a mark",
-			expected:     fmt.Sprintf("%s\t--%s\t%s\t%s\t\t\t%s%s%s\n", "", "long", "", "generic*", "usage", "", ""),
+			expected:     fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "long", "", "generic*", "usage", "", ""),
 			requiredMark: "*",
 			isRequired:   true,
 			flag:         mocks.NewFlagWithUsage("long", "", "usage"),
@@ -107,3 +110,298 @@ func TestTabbedHelpFormatter_Format(t *testing.T) {
 		})
 	}
 }
+
+func TestTabbedHelpFormatter_FormatHeader(t *testing.T) {
+	testCases := []struct {
+		title    string
+		sections *core.HelpSections
+		expected string
+	}{
+		{
+			title: "nil sections",
+		},
+		{
+			title:    "empty sections",
+			sections: &core.HelpSections{},
+		},
+		{
+			title:    "usage only",
+			sections: &core.HelpSections{Usage: "tool [flags]"},
+			expected: "Usage: tool [flags]\n\nFlags:\n",
+		},
+		{
+			title:    "description only",
+			sections: &core.HelpSections{Description: " description\n"},
+			expected: "description\n\nFlags:\n",
+		},
+		{
+			title:    "usage and description",
+			sections: &core.HelpSections{Usage: "tool [flags]", Description: "description"},
+			expected: "Usage: tool [flags]\n\ndescription\n\nFlags:\n",
+		},
+		{
+			title:    "grouped flags",
+			sections: &core.HelpSections{Usage: "tool [flags]", Grouped: true},
+			expected: "Usage: tool [flags]\n\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			formatter := &core.TabbedHelpFormatter{}
+			actual := formatter.FormatHeader(tc.sections)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpFormatter_FormatFooter(t *testing.T) {
+	testCases := []struct {
+		title    string
+		sections *core.HelpSections
+		expected string
+	}{
+		{
+			title: "nil sections",
+		},
+		{
+			title:    "empty sections",
+			sections: &core.HelpSections{Usage: "tool [flags]", Description: "description"},
+		},
+		{
+			title: "examples only",
+			sections: &core.HelpSections{Examples: []core.Example{
+				{Command: "tool --port 80", Description: "Listens on port 80"},
+				{Command: "tool -h"},
+			}},
+			expected: "\nExamples:\n  tool --port 80\n      Listens on port 80\n  tool -h\n",
+		},
+		{
+			title:    "footer only",
+			sections: &core.HelpSections{Footer: "footer\n"},
+			expected: "\nfooter\n",
+		},
+		{
+			title: "examples and footer",
+			sections: &core.HelpSections{
+				Examples: []core.Example{{Command: "tool -h"}},
+				Footer:   "footer",
+			},
+			expected: "\nExamples:\n  tool -h\n\nfooter\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			formatter := &core.TabbedHelpFormatter{}
+			actual := formatter.FormatFooter(tc.sections)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpFormatter_FormatGroup(t *testing.T) {
+	formatter := &core.TabbedHelpFormatter{}
+	if actual := formatter.FormatGroup("Networking", 0); actual != "Networking:\n" {
+		t.Errorf("Expected %q, Actual: %q", "Networking:\n", actual)
+	}
+	if actual := formatter.FormatGroup("Logging", 1); actual != "\nLogging:\n" {
+		t.Errorf("Expected %q, Actual: %q", "\nLogging:\n", actual)
+	}
+}
+
+func TestTabbedHelpFormatter_Format_Key(t *testing.T) {
+	lookup := func(key string) (string, bool) {
+		if key == "SET" {
+			return "secret", true
+		}
+		return "", false
+	}
+	testCases := []struct {
+		title       string
+		key         string
+		format      *core.KeyFormat
+		expectedKey string
+	}{
+		{
+			title:       "default format",
+			key:         "file-path",
+			expectedKey: "[$FILE_PATH]",
+		},
+		{
+			title: "disabled key",
+			key:   "-",
+		},
+		{
+			title:       "custom format",
+			key:         "port",
+			format:      &core.KeyFormat{FormatString: "env: %s"},
+			expectedKey: "env: PORT",
+		},
+		{
+			title:       "empty format string",
+			key:         "port",
+			format:      &core.KeyFormat{},
+			expectedKey: "PORT",
+		},
+		{
+			title:       "presence mark with the value set",
+			key:         "set",
+			format:      &core.KeyFormat{FormatString: "[$%s]", PresenceMark: "(set)", Lookup: lookup},
+			expectedKey: "[$SET] (set)",
+		},
+		{
+			title:       "presence mark without the value set",
+			key:         "unset",
+			format:      &core.KeyFormat{FormatString: "[$%s]", PresenceMark: "(set)", Lookup: lookup},
+			expectedKey: "[$UNSET]",
+		},
+		{
+			title:       "presence mark without lookup function",
+			key:         "set",
+			format:      &core.KeyFormat{FormatString: "[$%s]", PresenceMark: "(set)"},
+			expectedKey: "[$SET]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			formatter := &core.TabbedHelpFormatter{}
+			if tc.format != nil {
+				formatter.SetKeyFormat(tc.format)
+			}
+			f := mocks.NewFlagWithUsage("long", "", "usage").WithKey(tc.key)
+			expected := fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s\n", "", "long", tc.expectedKey, "generic", "usage")
+			actual := formatter.Format(f, "", "", "")
+			if actual != expected {
+				t.Errorf("Expected %q, Actual: %q", expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpFormatter_Key_Column(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flags    []core.Flag
+		expected string
+	}{
+		{
+			title: "no keys",
+			flags: []core.Flag{
+				core.NewInt("port", "Port"),
+				core.NewString("name", "Name"),
+			},
+			expected: "  --port  int         Port\n  --name  string      Name\n",
+		},
+		{
+			title: "some keys",
+			flags: []core.Flag{
+				core.NewInt("port", "Port").WithKey("port"),
+				core.NewString("name", "Name"),
+			},
+			expected: "  --port  [$PORT]  int         Port\n  --name           string      Name\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w := core.NewTabbedHelpWriter(buf)
+			formatter := &core.TabbedHelpFormatter{}
+			for _, f := range tc.flags {
+				_, _ = w.Write([]byte(formatter.Format(f, "", "", "")))
+			}
+			_ = w.Close()
+			actual := buf.String()
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpFormatter_Range(t *testing.T) {
+	flag := core.NewUInt16("port", "Port").WithBetween(1, 65535).WithDefault(80)
+	f := core.TabbedHelpFormatter{}
+
+	expected := fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "port", "", "uint16", "Port", " (range: [1, 65535])", " (default: 80)")
+	if actual := f.Format(flag, "", "(default: %v)", ""); actual != expected {
+		t.Errorf("Expected formatted result: %q, Actual: %q", expected, actual)
+	}
+
+	details := f.FormatDetails(&core.FlagDetails{
+		Flag:       flag,
+		Value:      "80",
+		Provenance: &core.Provenance{Origin: core.DefaultValue},
+	}, "", "", "")
+	if !strings.Contains(details, "Range:\t[1, 65535]\n") {
+		t.Errorf("Expected the range to be included in the details, Actual: %q", details)
+	}
+
+	f.SetMessageCatalogue(core.MessageMap{core.MsgRange: "Bereich: %s"})
+	expected = fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "port", "", "uint16", "Port", " (Bereich: [1, 65535])", "")
+	if actual := f.Format(flag, "", "", ""); actual != expected {
+		t.Errorf("Expected localised result: %q, Actual: %q", expected, actual)
+	}
+}
+
+func TestTabbedHelpFormatter_ByteSize_Default(t *testing.T) {
+	flag := core.NewByteSize("max-size", "Max size").WithDefault(10 * core.MB).WithMax(1 * core.GiB)
+	f := core.TabbedHelpFormatter{}
+
+	expected := fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "max-size", "", "bytesize", "Max size", " (range: <= 1GiB)", " (default: 10MB)")
+	if actual := f.Format(flag, "", "(default: %v)", ""); actual != expected {
+		t.Errorf("Expected formatted result: %q, Actual: %q", expected, actual)
+	}
+
+	details := f.FormatDetails(&core.FlagDetails{
+		Flag:       core.NewByteSizeSlice("sizes", "Sizes").WithDefault([]uint64{512, 3 * core.GiB / 2}),
+		Provenance: &core.Provenance{Origin: core.DefaultValue},
+	}, "", "", "")
+	if !strings.Contains(details, "Default:\t512B,1.5GiB\n") {
+		t.Errorf("Expected the human-readable default value in the details, Actual: %q", details)
+	}
+}
+
+func TestTabbedHelpFormatter_Constraints(t *testing.T) {
+	flag := core.NewStringSlice("emails", "Emails").WithFormat(core.EmailFormat).WithMaxItems(3).Unique()
+	f := core.TabbedHelpFormatter{}
+
+	expected := fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "emails", "", "[]string", "Emails", " (format: email address, items: <= 3, unique)", "")
+	if actual := f.Format(flag, "", "", ""); actual != expected {
+		t.Errorf("Expected formatted result: %q, Actual: %q", expected, actual)
+	}
+
+	f.SetMessageCatalogue(core.MessageMap{core.MsgConstraintsLabel: "Einschränkungen:", core.MsgUnique: "eindeutig"})
+	details := f.FormatDetails(&core.FlagDetails{
+		Flag:       flag,
+		Provenance: &core.Provenance{Origin: core.Unset},
+	}, "", "", "")
+	if !strings.Contains(details, "Einschränkungen:\tformat: email address, items: <= 3, eindeutig\n") {
+		t.Errorf("Expected the localised constraints to be included in the details, Actual: %q", details)
+	}
+}
+
+func TestTabbedHelpFormatter_Validators(t *testing.T) {
+	flag := core.NewInt("port", "Port").WithValidators(validate.Between(1, 65535), validate.Not(validate.OneOf(22)))
+	f := core.TabbedHelpFormatter{}
+
+	expected := fmt.Sprintf("%s\t--%s\t%s\v%s\t\t\t%s%s%s\n", "", "port", "", "int", "Port", " (range: [1, 65535], not (one of: 22))", "")
+	if actual := f.Format(flag, "", "", ""); actual != expected {
+		t.Errorf("Expected formatted result: %q, Actual: %q", expected, actual)
+	}
+
+	f.SetMessageCatalogue(core.MessageMap{core.MsgNot: "nicht (%s)", core.MsgOneOf: "eines von: %s"})
+	details := f.FormatDetails(&core.FlagDetails{
+		Flag:       flag,
+		Provenance: &core.Provenance{Origin: core.Unset},
+	}, "", "", "")
+	if !strings.Contains(details, "range: [1, 65535], nicht (eines von: 22)\n") {
+		t.Errorf("Expected the localised validator descriptions to be included in the details, Actual: %q", details)
+	}
+}
@@ -1,21 +1,74 @@
 package core
 
 import (
-	"fmt"
+	"bytes"
 	"io"
+	"strings"
 	"text/tabwriter"
+
+	"github.com/xitonix/flags/internal"
 )
 
+// minDescriptionWidth is the minimum width of the wrapped description column.
+const minDescriptionWidth = 20
+
 // TabbedHelpWriter represents a tabbed help writer.
+//
+// If the width of the output has been specified or the output is a terminal, the last column of each line (i.e. the flag usage)
+// will be wrapped with a hanging indent which is aligned under the description column.
 type TabbedHelpWriter struct {
-	w *tabwriter.Writer
+	w             *tabwriter.Writer
+	output        io.Writer
+	width         int
+	explicitWidth bool
+	buf           *bytes.Buffer
+	isTerminal    bool
 }
 
 // NewTabbedHelpWriter creates a new instance of a TabbedHelpWriter.
+//
+// The output width will be set to the width of the terminal, if the output is attached to one.
+// The lines will not be wrapped if the output is not a terminal. Use WithWidth(...) to override the detected width.
 func NewTabbedHelpWriter(output io.Writer) *TabbedHelpWriter {
 	return &TabbedHelpWriter{
-		w: tabwriter.NewWriter(output, 0, 0, 2, ' ', tabwriter.DiscardEmptyColumns),
+		w:          newTabWriter(output),
+		output:     output,
+		width:      internal.TerminalWidth(output),
+		buf:        &bytes.Buffer{},
+		isTerminal: internal.IsTerminal(output),
+	}
+}
+
+// WithWidth explicitly sets the output width.
+//
+// Setting the width to zero disables line wrapping.
+func (h *TabbedHelpWriter) WithWidth(width int) *TabbedHelpWriter {
+	if width < 0 {
+		width = 0
+	}
+	h.width = width
+	h.explicitWidth = true
+	return h
+}
+
+// SetTerminalWidth overrides the detected width of the terminal (i.e. by the value of the COLUMNS environment variable).
+//
+// The width will be ignored if the output is not a terminal, or the width has been explicitly set using WithWidth(...).
+func (h *TabbedHelpWriter) SetTerminalWidth(width int) {
+	if !h.isTerminal || h.explicitWidth || width <= 0 {
+		return
 	}
+	h.width = width
+}
+
+// Width returns the output width or zero if line wrapping has been disabled.
+func (h *TabbedHelpWriter) Width() int {
+	return h.width
+}
+
+// IsTerminal returns true if the output is a terminal.
+func (h *TabbedHelpWriter) IsTerminal() bool {
+	return h.isTerminal
 }
 
 // Write writes the formatted help lines to the specified output.
@@ -24,10 +77,101 @@ func (h *TabbedHelpWriter) Write(p []byte) (int, error) {
 	if len(p) == 0 {
 		return 0, nil
 	}
-	return fmt.Fprintf(h.w, string(p))
+	if h.width > 0 {
+		return h.buf.Write(p)
+	}
+	return h.w.Write(p)
 }
 
 // Close flushes the buffer.
 func (h *TabbedHelpWriter) Close() error {
-	return h.w.Flush()
+	if h.width == 0 || h.buf.Len() == 0 {
+		return h.w.Flush()
+	}
+	defer h.buf.Reset()
+	_, err := io.WriteString(h.output, h.wrap(h.buf.String()))
+	return err
+}
+
+// wrap aligns the columns of the input and wraps the last column of each line to the output width.
+func (h *TabbedHelpWriter) wrap(input string) string {
+	lines := strings.Split(input, "\n")
+	descriptions := make([]string, len(lines))
+
+	// The description column is excluded from alignment to calculate the width of the aligned columns.
+	aligned := &bytes.Buffer{}
+	tw := newTabWriter(aligned)
+	for i, line := range lines {
+		if i > 0 {
+			_, _ = tw.Write([]byte("\n"))
+		}
+		if index := strings.LastIndex(line, "\t"); index >= 0 {
+			descriptions[i] = line[index+1:]
+			line = line[:index+1]
+		}
+		_, _ = tw.Write([]byte(line))
+	}
+	_ = tw.Flush()
+
+	result := &strings.Builder{}
+	for i, prefix := range strings.Split(aligned.String(), "\n") {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		if i >= len(lines) {
+			result.WriteString(prefix)
+			continue
+		}
+		if !strings.Contains(lines[i], "\t") {
+			// Plain lines are wrapped with a hanging indent equal to their leading white spaces.
+			indent := len(prefix) - len(strings.TrimLeft(prefix, " "))
+			result.WriteString(wrapText(prefix[:indent], strings.TrimLeft(prefix, " "), indent, h.width))
+			continue
+		}
+		if internal.IsEmpty(descriptions[i]) {
+			result.WriteString(strings.TrimRight(prefix, " "))
+			continue
+		}
+		result.WriteString(wrapText(prefix, descriptions[i], internal.VisibleWidth(prefix), h.width))
+	}
+	return result.String()
+}
+
+// wrapText wraps the text to the specified width, with the continuation lines indented by the given number of spaces.
+//
+// The first line will be prefixed by the prefix string.
+func wrapText(prefix, text string, indent, width int) string {
+	available := width - indent
+	if available < minDescriptionWidth {
+		available = minDescriptionWidth
+	}
+	if indent+internal.VisibleWidth(text) <= width {
+		return prefix + text
+	}
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return strings.TrimRight(prefix, " ")
+	}
+
+	sb := &strings.Builder{}
+	sb.WriteString(prefix)
+	lineWidth := 0
+	for _, word := range words {
+		wordWidth := internal.VisibleWidth(word)
+		if lineWidth > 0 && lineWidth+1+wordWidth > available {
+			sb.WriteString("\n" + strings.Repeat(" ", indent))
+			lineWidth = 0
+		}
+		if lineWidth > 0 {
+			sb.WriteString(" ")
+			lineWidth++
+		}
+		sb.WriteString(word)
+		lineWidth += wordWidth
+	}
+	return sb.String()
+}
+
+func newTabWriter(output io.Writer) *tabwriter.Writer {
+	return tabwriter.NewWriter(output, 0, 0, 2, ' ', tabwriter.DiscardEmptyColumns)
 }
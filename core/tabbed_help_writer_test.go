@@ -24,6 +24,11 @@ func TestTabbedHelpWriter_Write(t *testing.T) {
 			input:    []byte("input"),
 			expected: "input",
 		},
+		{
+			title:    "input with formatting verbs",
+			input:    []byte("100%s"),
+			expected: "100%s",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -55,3 +60,143 @@ func TestTabbedHelpWriter_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestTabbedHelpWriter_Wrap(t *testing.T) {
+	testCases := []struct {
+		title    string
+		width    int
+		input    []string
+		expected string
+	}{
+		{
+			title:    "short lines",
+			width:    80,
+			input:    []string{"-p,\t--port\tPORT\tint\t\t\tPort\n", "\t--name\t\tstring\t\t\tName\n"},
+			expected: "-p,  --port  PORT  int         Port\n     --name        string      Name\n",
+		},
+		{
+			title: "long usage with hanging indent",
+			width: 40,
+			input: []string{
+				"-p,\t--port\tPORT\tint\t\t\tThe port number on which the server listens\n",
+				"\t--name\t\tstring\t\t\tName\n",
+			},
+			expected: "-p,  --port  PORT  int         The port number on\n" +
+				"                               which the server\n" +
+				"                               listens\n" +
+				"     --name        string      Name\n",
+		},
+		{
+			title: "minimum description width",
+			width: 10,
+			input: []string{"\t--port\t\tint\t\t\tThe port number on which the server listens\n"},
+			expected: "  --port    int      The port number on\n" +
+				"                     which the server\n" +
+				"                     listens\n",
+		},
+		{
+			title:    "empty usage",
+			width:    40,
+			input:    []string{"\t--port\t\tint\t\t\t\n"},
+			expected: "  --port    int\n",
+		},
+		{
+			title: "plain lines",
+			width: 30,
+			input: []string{
+				"Usage: tool [flags]\n\n",
+				"This is a description which must be wrapped.\n",
+				"  tool --port 80\n",
+				"      An indented example description.\n",
+			},
+			expected: "Usage: tool [flags]\n\n" +
+				"This is a description which\nmust be wrapped.\n" +
+				"  tool --port 80\n" +
+				"      An indented example\n      description.\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w := NewTabbedHelpWriter(buf).WithWidth(tc.width)
+			if w.Width() != tc.width {
+				t.Errorf("Expected width: %d, Actual: %d", tc.width, w.Width())
+			}
+			for _, line := range tc.input {
+				n, err := w.Write([]byte(line))
+				if err != nil {
+					t.Errorf("Write: Expected a nil error, Actual: %v", err)
+				}
+				if n != len(line) {
+					t.Errorf("Expected number of written bytes: %d, Actual: %d", len(line), n)
+				}
+			}
+			if buf.Len() != 0 {
+				t.Errorf("Did not expect the output to be written before closing the writer")
+			}
+			if err := w.Close(); err != nil {
+				t.Errorf("Close: Expected a nil error, Actual: %v", err)
+			}
+			actual := buf.String()
+			if actual != tc.expected {
+				t.Errorf("Expected:\n%q\nActual:\n%q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpWriter_WithWidth(t *testing.T) {
+	w := NewTabbedHelpWriter(&bytes.Buffer{}).WithWidth(-1)
+	if w.Width() != 0 {
+		t.Errorf("Expected the negative width to disable wrapping, Actual width: %d", w.Width())
+	}
+}
+
+func TestTabbedHelpWriter_SetTerminalWidth(t *testing.T) {
+	testCases := []struct {
+		title      string
+		writer     *TabbedHelpWriter
+		isTerminal bool
+		width      int
+		expected   int
+	}{
+		{
+			title:    "not a terminal",
+			writer:   NewTabbedHelpWriter(&bytes.Buffer{}),
+			width:    80,
+			expected: 0,
+		},
+		{
+			title:      "terminal",
+			writer:     NewTabbedHelpWriter(&bytes.Buffer{}),
+			isTerminal: true,
+			width:      80,
+			expected:   80,
+		},
+		{
+			title:      "invalid width",
+			writer:     NewTabbedHelpWriter(&bytes.Buffer{}),
+			isTerminal: true,
+			width:      -1,
+			expected:   0,
+		},
+		{
+			title:      "explicit width takes priority",
+			writer:     NewTabbedHelpWriter(&bytes.Buffer{}).WithWidth(100),
+			isTerminal: true,
+			width:      80,
+			expected:   100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			tc.writer.isTerminal = tc.isTerminal
+			tc.writer.SetTerminalWidth(tc.width)
+			if tc.writer.Width() != tc.expected {
+				t.Errorf("Expected width: %d, Actual: %d", tc.expected, tc.writer.Width())
+			}
+		})
+	}
+}
@@ -0,0 +1,318 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// DefaultFlagTemplate is the default flag template of TemplateHelpFormatter.
+const DefaultFlagTemplate = `{{with .ShortName}}-{{.}}, {{end}}--{{.LongName}}{{with .FormattedKey}} {{.}}{{end}} {{.Type}}{{.RequiredMark}}
+{{with .Usage}}{{wrap 76 . | indent 4}}
+{{end}}{{with .FormattedDefault}}{{indent 4 .}}
+{{end}}{{with .ValidValues}}    {{message "help.label.valid_values"}} {{join ", " .}}
+{{end}}{{with .Range}}    {{message "help.label.range"}} {{.}}
+{{end}}{{with .DeprecationMark}}{{indent 4 .}}
+{{end}}`
+
+// FlagView is the view model of a flag which will be passed to the templates of TemplateHelpFormatter.
+type FlagView struct {
+	// Flag is the flag itself.
+	Flag Flag
+	// LongName is the long name of the flag (i.e. port).
+	LongName string
+	// ShortName is the short name of the flag (i.e. p).
+	ShortName string
+	// Aliases is the list of all the names by which the flag can be provided on the command line (i.e. -p, --port).
+	Aliases []string
+	// Usage is the usage string of the flag.
+	Usage string
+	// Description is the long description of the flag.
+	Description string
+	// Examples is the list of the flag's usage examples.
+	Examples []string
+	// Type is the string representation of the flag's type.
+	Type string
+	// Group is the name of the group to which the flag belongs.
+	Group string
+	// Key is the key of the flag (i.e. PORT).
+	Key string
+	// FormattedKey is the key which has been formatted using the bucket's key format string (i.e. [$PORT]).
+	FormattedKey string
+	// HasDefault is true if the flag has a default value.
+	HasDefault bool
+	// Default is the string representation of the default value.
+	Default string
+	// FormattedDefault is the default value which has been formatted using the bucket's default value format string.
+	FormattedDefault string
+	// ValidValues is the list of acceptable values.
+	ValidValues []string
+	// Range is the string representation of the acceptable range of values (i.e. [1, 65535]).
+	Range string
+	// Constraints is the list of human-readable constraints of the flag value (i.e. "required").
+	Constraints []string
+	// IsRequired is true if the flag is mandatory.
+	IsRequired bool
+	// RequiredMark is the bucket's required flag mark if the flag is mandatory, otherwise an empty string.
+	RequiredMark string
+	// IsDeprecated is true if the flag has been deprecated.
+	IsDeprecated bool
+	// DeprecationMark is the bucket's deprecation mark if the flag has been deprecated, otherwise an empty string.
+	DeprecationMark string
+	// Deprecation holds the deprecation details of the flag or nil if the flag is not deprecated.
+	Deprecation *Deprecation
+	// IsHidden is true if the flag is hidden.
+	IsHidden bool
+	// Provenance holds the details of where the current value of the flag came from.
+	//
+	// The sensitive parts of the provenance value will be masked (See ValueMasker).
+	Provenance *Provenance
+	// Value is the string representation of the value to which the flag resolves.
+	//
+	// The value is only available on the help page of a single flag (i.e. --help=port).
+	Value string
+}
+
+// TemplateHelpFormatter represents a help formatter which renders the help output using text/template.
+//
+// The root template will be executed for each flag, with a *FlagView as its data. The optional "header" and "footer"
+// templates will be executed with the *HelpSections and the optional "group" template will be executed with the
+// name of each group, if they have been defined (i.e. {{define "header"}}Usage: {{.Usage}}{{end}}).
+//
+// The help page of a single flag (i.e. --help=port) will be rendered using the optional "details" template. The root
+// template will be used instead, if the "details" template has not been defined.
+//
+// Apart from the standard functions, the templates can use the following functions:
+//
+// 	indent: Indents all the lines by the specified number of spaces (i.e. {{indent 4 .Usage}}).
+// 	wrap: Wraps the text to the specified width (i.e. {{wrap 80 .Usage}}).
+// 	join: Concatenates the items using the specified separator (i.e. {{join ", " .ValidValues}}).
+// 	message: Returns the translated text of a message (i.e. {{message "help.heading.usage"}}). See core.MsgUsageHeading.
+type TemplateHelpFormatter struct {
+	tmpl         *template.Template
+	keyFormat    *KeyFormat
+	provenance   func(f Flag) *Provenance
+	revealHidden bool
+	messages     MessageCatalogue
+}
+
+// NewTemplateHelpFormatter creates a new template help formatter.
+//
+// The text will be parsed as the root template. The custom functions (if any) will be added to the built-in
+// template functions and override them if they have the same name. Hidden flags will not be passed to the template.
+func NewTemplateHelpFormatter(text string, funcs template.FuncMap) (*TemplateHelpFormatter, error) {
+	t := &TemplateHelpFormatter{}
+	tmpl, err := template.New("flag").Funcs(t.templateFuncs()).Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	t.tmpl = tmpl
+	return t, nil
+}
+
+// SetMessageCatalogue sets the catalogue which will be used by the message template function.
+func (t *TemplateHelpFormatter) SetMessageCatalogue(catalogue MessageCatalogue) {
+	t.messages = catalogue
+}
+
+// SetKeyFormat sets the settings which control how the flag keys are rendered in the help output.
+func (t *TemplateHelpFormatter) SetKeyFormat(format *KeyFormat) {
+	t.keyFormat = format
+}
+
+// SetProvenanceLookup sets the function which returns the provenance of a flag's value.
+func (t *TemplateHelpFormatter) SetProvenanceLookup(lookup func(f Flag) *Provenance) {
+	t.provenance = lookup
+}
+
+// RevealHidden sets whether the hidden flags must be passed to the template.
+func (t *TemplateHelpFormatter) RevealHidden(reveal bool) {
+	t.revealHidden = reveal
+}
+
+// Format executes the root template for the flag.
+//
+// The error message will be returned if the template execution fails.
+func (t *TemplateHelpFormatter) Format(f Flag, deprecationMark, defaultValueFormatString, requiredMark string) string {
+	if f.IsHidden() && !t.revealHidden {
+		return ""
+	}
+	return t.execute(t.tmpl, t.view(f, deprecationMark, defaultValueFormatString, requiredMark))
+}
+
+// FormatDetails executes the "details" template (or the root template, if "details" is not defined) for the flag.
+func (t *TemplateHelpFormatter) FormatDetails(details *FlagDetails, deprecationMark, defaultValueFormatString, requiredMark string) string {
+	if details == nil || details.Flag == nil {
+		return ""
+	}
+	view := t.view(details.Flag, deprecationMark, defaultValueFormatString, requiredMark)
+	view.Value = details.Value
+	view.Provenance = MaskProvenanceOf(details.Flag, details.Provenance)
+	tmpl := t.tmpl.Lookup("details")
+	if tmpl == nil {
+		tmpl = t.tmpl
+	}
+	return t.execute(tmpl, view)
+}
+
+// FormatHeader executes the "header" template (if defined).
+func (t *TemplateHelpFormatter) FormatHeader(sections *HelpSections) string {
+	return t.executeNamed("header", sections)
+}
+
+// FormatFooter executes the "footer" template (if defined).
+func (t *TemplateHelpFormatter) FormatFooter(sections *HelpSections) string {
+	return t.executeNamed("footer", sections)
+}
+
+// FormatGroup executes the "group" template (if defined) with the group name.
+func (t *TemplateHelpFormatter) FormatGroup(name string, index int) string {
+	return t.executeNamed("group", name)
+}
+
+func (t *TemplateHelpFormatter) executeNamed(name string, data interface{}) string {
+	tmpl := t.tmpl.Lookup(name)
+	if tmpl == nil {
+		return ""
+	}
+	return t.execute(tmpl, data)
+}
+
+func (t *TemplateHelpFormatter) execute(tmpl *template.Template, data interface{}) string {
+	sb := &strings.Builder{}
+	if err := tmpl.Execute(sb, data); err != nil {
+		return err.Error() + "\n"
+	}
+	return sb.String()
+}
+
+func (t *TemplateHelpFormatter) view(f Flag, deprecationMark, defaultValueFormatString, requiredMark string) *FlagView {
+	view := &FlagView{
+		Flag:         f,
+		LongName:     f.LongName(),
+		ShortName:    f.ShortName(),
+		Aliases:      make([]string, 0),
+		Usage:        f.Usage(),
+		Description:  DescriptionOf(f),
+		Examples:     ExamplesOf(f),
+		Type:         f.Type(),
+		Group:        GroupOf(f),
+		Key:          f.Key().String(),
+		IsRequired:   f.IsRequired(),
+		IsDeprecated: f.IsDeprecated(),
+		IsHidden:     f.IsHidden(),
+		Constraints:  make([]string, 0),
+	}
+
+	if !internal.IsEmpty(view.ShortName) {
+		view.Aliases = append(view.Aliases, "-"+view.ShortName)
+	}
+	view.Aliases = append(view.Aliases, "--"+view.LongName)
+
+	view.FormattedKey = formatKey(t.keyFormat, view.Key)
+
+	if dv := f.Default(); dv != nil {
+		view.HasDefault = true
+		view.Default = FormatValue(dv)
+		if !internal.IsEmpty(defaultValueFormatString) {
+			view.FormattedDefault = fmt.Sprintf(defaultValueFormatString, view.Default)
+		}
+	}
+
+	if p, ok := f.(ValidRangeProvider); ok {
+		view.ValidValues = p.ValidRange()
+	}
+	view.Range = RangeOf(f).String()
+
+	if view.IsRequired {
+		view.RequiredMark = requiredMark
+		view.Constraints = append(view.Constraints, messageOf(t.messages, MsgStatusRequired))
+	}
+	if len(view.ValidValues) > 0 {
+		view.Constraints = append(view.Constraints, messageOf(t.messages, MsgOneOf, strings.Join(view.ValidValues, ", ")))
+	}
+	if !internal.IsEmpty(view.Range) {
+		view.Constraints = append(view.Constraints, messageOf(t.messages, MsgRange, view.Range))
+	}
+	for _, c := range ConstraintsOf(f) {
+		view.Constraints = append(view.Constraints, c.Format(t.messages))
+	}
+
+	if view.IsDeprecated {
+		view.DeprecationMark = deprecationMark
+		if p, ok := f.(DeprecationProvider); ok {
+			view.Deprecation = p.Deprecation()
+		}
+	}
+
+	if t.provenance != nil {
+		view.Provenance = MaskProvenanceOf(f, t.provenance(f))
+	}
+	return view
+}
+
+// FormatValue returns the string representation of a flag value.
+//
+// The items of slices and maps will be separated by commas, the map items will be sorted by key and
+// time values will be formatted according to RFC3339.
+func FormatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		items := make([]string, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			items[i] = FormatValue(rv.Index(i).Interface())
+		}
+		return strings.Join(items, ",")
+	case reflect.Map:
+		items := make([]string, 0, rv.Len())
+		for _, key := range rv.MapKeys() {
+			items = append(items, FormatValue(key.Interface())+"="+FormatValue(rv.MapIndex(key).Interface()))
+		}
+		sort.Strings(items)
+		return strings.Join(items, ",")
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func (t *TemplateHelpFormatter) templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"message": func(id string, args ...interface{}) string {
+			return messageOf(t.messages, id, args...)
+		},
+		"indent": indent,
+		"wrap": func(width int, text string) string {
+			return wrapText("", text, 0, width)
+		},
+		"join": func(separator string, items []string) string {
+			return strings.Join(items, separator)
+		},
+	}
+}
+
+// indent indents all the non-empty lines of the text by the specified number of spaces.
+func indent(spaces int, text string) string {
+	padding := strings.Repeat(" ", spaces)
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if len(line) > 0 {
+			lines[i] = padding + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
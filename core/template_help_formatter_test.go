@@ -0,0 +1,270 @@
+package core_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func TestTemplateHelpFormatter_Format(t *testing.T) {
+	testCases := []struct {
+		title      string
+		template   string
+		funcs      template.FuncMap
+		flag       core.Flag
+		provenance *core.Provenance
+		expected   string
+	}{
+		{
+			title:    "default template",
+			template: core.DefaultFlagTemplate,
+			flag:     core.NewInt("port", "Port number").WithShort("p").WithKey("port").WithDefault(80).WithValidRange(80, 443).Required(),
+			expected: "-p, --port [$PORT] int*\n    Port number\n    (default: 80)\n    Valid values: 80, 443\n",
+		},
+		{
+			title:    "default template with bounds",
+			template: core.DefaultFlagTemplate,
+			flag:     core.NewFloat64("ratio", "Ratio").WithBetween(0, 1, core.Exclusive),
+			expected: "--ratio float64\n    Ratio\n    Range: (0, 1)\n",
+		},
+		{
+			title:    "range constraint",
+			template: `{{.Range}} {{join "; " .Constraints}}`,
+			flag:     core.NewDuration("timeout", "").WithMin(time.Second),
+			expected: ">= 1s range: >= 1s",
+		},
+		{
+			title:    "default template with deprecated flag",
+			template: core.DefaultFlagTemplate,
+			flag:     core.NewBool("old", "").MarkAsDeprecated(),
+			expected: "--old bool\n    [DEPRECATED]\n",
+		},
+		{
+			title:    "hidden flag",
+			template: core.DefaultFlagTemplate,
+			flag:     core.NewBool("secret", "").Hide(),
+			expected: "",
+		},
+		{
+			title:    "aliases, group and constraints",
+			template: `{{join "|" .Aliases}} {{.Group}} {{join "; " .Constraints}}`,
+			flag:     core.NewString("mode", "").WithShort("m").WithGroup("General").WithValidRange(false, "a", "b").Required(),
+			expected: "-m|--mode General required; one of: a, b",
+		},
+		{
+			title:    "default values formatted per type",
+			template: `{{.Default}}`,
+			flag:     core.NewDurationSlice("delays", "").WithDefault([]time.Duration{time.Second, time.Minute}),
+			expected: "1s,1m0s",
+		},
+		{
+			title:      "provenance",
+			template:   `{{.Provenance}}`,
+			flag:       core.NewString("name", ""),
+			provenance: &core.Provenance{Origin: core.Environment, Name: "NAME"},
+			expected:   "environment ($NAME)",
+		},
+		{
+			title:      "masked provenance value",
+			template:   `{{.Provenance.Value}}`,
+			flag:       core.NewURL("url", "").WithSensitiveQuery("token"),
+			provenance: &core.Provenance{Origin: core.CommandLine, Name: "--url", Value: "https://h/?token=secret"},
+			expected:   "https://h/?token=xxxxx",
+		},
+		{
+			title:    "no provenance lookup",
+			template: `{{.Provenance}}`,
+			flag:     core.NewString("name", ""),
+			expected: "unset",
+		},
+		{
+			title:    "wrap and indent",
+			template: `{{wrap 20 .Usage | indent 2}}`,
+			flag:     core.NewString("name", "This is a long usage string which must be wrapped"),
+			expected: "  This is a long usage\n  string which must be\n  wrapped",
+		},
+		{
+			title:    "custom functions",
+			template: `{{upper .LongName}}`,
+			funcs:    template.FuncMap{"upper": strings.ToUpper},
+			flag:     core.NewString("name", ""),
+			expected: "NAME",
+		},
+		{
+			title:    "access to the flag",
+			template: `{{.Flag.Type}}`,
+			flag:     mocks.NewFlag("name", ""),
+			expected: "generic",
+		},
+		{
+			title:    "execution error",
+			template: `{{.Unknown}}`,
+			flag:     core.NewString("name", ""),
+			expected: `template: flag:1:2: executing "flag" at <.Unknown>: can't evaluate field Unknown in type *core.FlagView` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			formatter, err := core.NewTemplateHelpFormatter(tc.template, tc.funcs)
+			if err != nil {
+				t.Fatalf("Expected no error, but received %v", err)
+			}
+			if tc.provenance != nil {
+				formatter.SetProvenanceLookup(func(f core.Flag) *core.Provenance {
+					return tc.provenance
+				})
+			}
+			actual := formatter.Format(tc.flag, "[DEPRECATED]", "(default: %v)", "*")
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateHelpFormatter_Sections(t *testing.T) {
+	text := `{{define "header"}}Usage: {{.Usage}}
+{{end}}{{define "group"}}[{{.}}]
+{{end}}{{define "footer"}}{{.Footer}}
+{{end}}--{{.LongName}}
+`
+	formatter, err := core.NewTemplateHelpFormatter(text, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	sections := &core.HelpSections{Usage: "tool [flags]", Footer: "footer"}
+	if actual := formatter.FormatHeader(sections); actual != "Usage: tool [flags]\n" {
+		t.Errorf("Unexpected header: %q", actual)
+	}
+	if actual := formatter.FormatGroup("Networking", 0); actual != "[Networking]\n" {
+		t.Errorf("Unexpected group heading: %q", actual)
+	}
+	if actual := formatter.FormatFooter(sections); actual != "footer\n" {
+		t.Errorf("Unexpected footer: %q", actual)
+	}
+
+	formatter, _ = core.NewTemplateHelpFormatter(`--{{.LongName}}`, nil)
+	if formatter.FormatHeader(sections) != "" || formatter.FormatFooter(sections) != "" || formatter.FormatGroup("group", 0) != "" {
+		t.Errorf("Expected the undefined sections to be empty")
+	}
+}
+
+func TestTemplateHelpFormatter_Key_Format(t *testing.T) {
+	formatter, _ := core.NewTemplateHelpFormatter(`{{.Key}} {{.FormattedKey}}`, nil)
+	formatter.SetKeyFormat(&core.KeyFormat{FormatString: "env:%s"})
+	actual := formatter.Format(core.NewString("name", "").WithKey("name"), "", "", "")
+	if actual != "NAME env:NAME" {
+		t.Errorf("Expected %q, Actual: %q", "NAME env:NAME", actual)
+	}
+}
+
+func TestNewTemplateHelpFormatter_Invalid_Template(t *testing.T) {
+	_, err := core.NewTemplateHelpFormatter(`{{.LongName`, nil)
+	if err == nil {
+		t.Errorf("Expected a template parsing error")
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	testCases := []struct {
+		title    string
+		value    interface{}
+		expected string
+	}{
+		{
+			title: "nil",
+		},
+		{
+			title:    "string",
+			value:    "value",
+			expected: "value",
+		},
+		{
+			title:    "integer",
+			value:    10,
+			expected: "10",
+		},
+		{
+			title:    "duration",
+			value:    time.Minute,
+			expected: "1m0s",
+		},
+		{
+			title:    "time",
+			value:    time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+			expected: "2020-01-02T03:04:05Z",
+		},
+		{
+			title:    "ip address",
+			value:    net.ParseIP("127.0.0.1"),
+			expected: "127.0.0.1",
+		},
+		{
+			title:    "string slice",
+			value:    []string{"a", "b"},
+			expected: "a,b",
+		},
+		{
+			title:    "map",
+			value:    map[string]string{"b": "2", "a": "1"},
+			expected: "a=1,b=2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := core.FormatValue(tc.value)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateHelpFormatter_FormatDetails(t *testing.T) {
+	flag := core.NewInt("port", "Port").WithDescription("Description").WithExamples("tool --port 80").Hide()
+	details := &core.FlagDetails{
+		Flag:       flag,
+		Value:      "80",
+		Provenance: &core.Provenance{Origin: core.CommandLine, Name: "--port"},
+	}
+
+	formatter, _ := core.NewTemplateHelpFormatter(`--{{.LongName}}{{define "details"}}{{.Description}}|{{join "," .Examples}}|{{.Value}}|{{.Provenance}}{{end}}`, nil)
+	expected := "Description|tool --port 80|80|command line (--port)"
+	if actual := formatter.FormatDetails(details, "", "", ""); actual != expected {
+		t.Errorf("Expected %q, Actual: %q", expected, actual)
+	}
+
+	formatter, _ = core.NewTemplateHelpFormatter(`--{{.LongName}} {{.Value}}`, nil)
+	expected = "--port 80"
+	if actual := formatter.FormatDetails(details, "", "", ""); actual != expected {
+		t.Errorf("Expected the root template to be used: %q, Actual: %q", expected, actual)
+	}
+
+	masked := &core.FlagDetails{
+		Flag:       core.NewURL("url", "").WithSensitiveQuery(),
+		Provenance: &core.Provenance{Origin: core.CommandLine, Name: "--url", Value: "https://h/?token=secret"},
+	}
+	maskFormatter, _ := core.NewTemplateHelpFormatter(`{{.Provenance.Value}}`, nil)
+	expected = "https://h/?token=xxxxx"
+	if actual := maskFormatter.FormatDetails(masked, "", "", ""); actual != expected {
+		t.Errorf("Expected the provenance value to be masked: %q, Actual: %q", expected, actual)
+	}
+	if masked.Provenance.Value != "https://h/?token=secret" {
+		t.Errorf("Expected the original provenance to remain unchanged, Actual: %q", masked.Provenance.Value)
+	}
+
+	if actual := formatter.Format(flag, "", "", ""); actual != "" {
+		t.Errorf("Expected the hidden flag to be skipped, Actual: %q", actual)
+	}
+	formatter.RevealHidden(true)
+	if actual := formatter.Format(flag, "", "", ""); actual != "--port " {
+		t.Errorf("Expected the hidden flag to be revealed, Actual: %q", actual)
+	}
+}
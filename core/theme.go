@@ -0,0 +1,83 @@
+package core
+
+// Style represents an ANSI SGR (Select Graphic Rendition) style, such as "1" for bold or "1;31" for bold red.
+type Style string
+
+const (
+	// NoStyle leaves the text unchanged.
+	NoStyle Style = ""
+	// Bold bold text.
+	Bold Style = "1"
+	// Dim dimmed (faint) text.
+	Dim Style = "2"
+	// Red red text.
+	Red Style = "31"
+	// Yellow yellow text.
+	Yellow Style = "33"
+	// BoldRed bold red text.
+	BoldRed Style = "1;31"
+)
+
+// Apply wraps the text in the style's escape sequences.
+//
+// Empty strings will also be wrapped, so that all the cells of a styled column have the same number of invisible
+// characters and the column alignment remains intact.
+func (s Style) Apply(text string) string {
+	if s == NoStyle {
+		return text
+	}
+	return "\x1b[" + string(s) + "m" + text + "\x1b[0m"
+}
+
+// Theme holds the styles of the help and error output elements.
+type Theme struct {
+	// FlagName the style of the short and long names of the flags.
+	FlagName Style
+	// Type the style of the flag types.
+	Type Style
+	// RequiredMark the style of the required flag mark.
+	RequiredMark Style
+	// DeprecationMark the style of the deprecated flag mark.
+	DeprecationMark Style
+	// Heading the style of the section and group headings.
+	Heading Style
+	// Error the style of the error messages.
+	Error Style
+	// Warning the style of the warning messages (i.e. core.DeprecationWarning).
+	Warning Style
+}
+
+// DefaultTheme returns the default theme.
+func DefaultTheme() *Theme {
+	return &Theme{
+		FlagName:        Bold,
+		Type:            Dim,
+		RequiredMark:    BoldRed,
+		DeprecationMark: Yellow,
+		Heading:         Bold,
+		Error:           Red,
+		Warning:         Yellow,
+	}
+}
+
+// Themeable is an optional interface for the help formatters and loggers which support styled output.
+type Themeable interface {
+	// SetTheme sets the theme of the output. A nil theme disables styling.
+	SetTheme(theme *Theme)
+}
+
+// TerminalWriter is an optional interface for the help writers which can report whether their output is a terminal.
+//
+// Styling will be disabled if the help writer does not implement this interface.
+type TerminalWriter interface {
+	// IsTerminal returns true if the output is a terminal.
+	IsTerminal() bool
+}
+
+// TerminalWidthSetter is an optional interface for the help writers which wrap the lines to the width of the terminal.
+//
+// The bucket will override the detected width by the value of the COLUMNS environment variable (if set).
+type TerminalWidthSetter interface {
+	// SetTerminalWidth overrides the detected width of the terminal.
+	SetTerminalWidth(width int)
+}
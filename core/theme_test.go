@@ -0,0 +1,85 @@
+package core_test
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestStyle_Apply(t *testing.T) {
+	testCases := []struct {
+		title    string
+		style    core.Style
+		text     string
+		expected string
+	}{
+		{
+			title:    "no style",
+			style:    core.NoStyle,
+			text:     "text",
+			expected: "text",
+		},
+		{
+			title:    "bold",
+			style:    core.Bold,
+			text:     "text",
+			expected: "\x1b[1mtext\x1b[0m",
+		},
+		{
+			title:    "empty text",
+			style:    core.BoldRed,
+			expected: "\x1b[1;31m\x1b[0m",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := tc.style.Apply(tc.text)
+			if actual != tc.expected {
+				t.Errorf("Expected %q, Actual: %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTabbedHelpFormatter_Theme_Alignment(t *testing.T) {
+	flags := []core.Flag{
+		core.NewInt("port", "Port number").WithShort("p").WithDefault(80),
+		core.NewString("name", "Name").WithKey("NAME").Required(),
+		core.NewBool("verbose", "Verbose mode").MarkAsDeprecated(),
+		core.NewDuration("timeout-in-seconds", "Timeout").Required(),
+	}
+
+	render := func(theme *core.Theme, width int) string {
+		buf := &bytes.Buffer{}
+		formatter := &core.TabbedHelpFormatter{}
+		formatter.SetTheme(theme)
+		w := core.NewTabbedHelpWriter(buf).WithWidth(width)
+		_, _ = w.Write([]byte(formatter.FormatHeader(&core.HelpSections{Usage: "tool [flags]"})))
+		for _, f := range flags {
+			_, _ = w.Write([]byte(formatter.Format(f, "[DEPRECATED]", "(default: %v)", "*")))
+		}
+		_ = w.Close()
+		return buf.String()
+	}
+
+	for _, width := range []int{0, 40} {
+		plain := render(nil, width)
+		styled := render(core.DefaultTheme(), width)
+		if plain == styled {
+			t.Errorf("Expected the output to be styled with width %d", width)
+		}
+		stripped := stripEscapes(styled)
+		if stripped != plain {
+			t.Errorf("Expected the styled output to be aligned the same as the plain output with width %d.\nPlain:\n%s\nStyled:\n%s", width, plain, stripped)
+		}
+	}
+}
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+func stripEscapes(s string) string {
+	return ansiEscape.ReplaceAllString(s, "")
+}
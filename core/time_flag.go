@@ -1,7 +1,6 @@
 package core
 
 import (
-	"fmt"
 	"strings"
 	"time"
 
@@ -91,12 +90,16 @@ type TimeFlag struct {
 	long, short         string
 	usage               string
 	isSet               bool
-	isDeprecated        bool
+	deprecation         *Deprecation
 	isRequired          bool
 	isHidden            bool
+	group               string
+	description         string
+	examples            []string
 	validate            func(in time.Time) error
 	validationList      map[time.Time]interface{}
 	acceptedItems       []time.Time
+	validators          []Validator
 }
 
 // NewTime creates anew time flag.
@@ -135,7 +138,7 @@ func (f *TimeFlag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is deprecated.
 func (f *TimeFlag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -220,21 +223,67 @@ func (f *TimeFlag) Hide() *TimeFlag {
 	return f
 }
 
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *TimeFlag) WithGroup(name string) *TimeFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *TimeFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *TimeFlag) WithDescription(description string) *TimeFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *TimeFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *TimeFlag) WithExamples(examples ...string) *TimeFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *TimeFlag) Examples() []string {
+	return f.examples
+}
+
 // MarkAsDeprecated marks the flag as deprecated.
 //
 // A deprecated flag will be marked in the help output to draw the users' attention.
 // The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
 //
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
 // Example:
 //
 // 	flags.SetDeprecationMark("**DEPRECATED**")
 //  OR
 // 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
-func (f *TimeFlag) MarkAsDeprecated() *TimeFlag {
-	f.isDeprecated = true
+func (f *TimeFlag) MarkAsDeprecated(options ...DeprecationOption) *TimeFlag {
+	f.deprecation = newDeprecation(options)
 	return f
 }
 
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *TimeFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
 // WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
 //
 // The set operation will fail if the callback returns an error.
@@ -245,6 +294,20 @@ func (f *TimeFlag) WithValidationCallback(validate func(in time.Time) error) *Ti
 	return f
 }
 
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *TimeFlag) WithValidators(validators ...Validator) *TimeFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the descriptions of the validators of the flag.
+func (f *TimeFlag) Constraints() []Constraint {
+	return describeValidators(f.validators)
+}
+
 // WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
 //
 // The set operation will fail if the flag value is not from the specified list.
@@ -305,19 +368,8 @@ func (f *TimeFlag) Set(value string) error {
 
 	for _, layout := range layouts {
 		if t, err := time.Parse(layout, value); err == nil {
-
-			if f.validate != nil {
-				err := f.validate(t)
-				if err != nil {
-					return err
-				}
-			}
-
-			// Validation callback takes priority over validation list
-			if f.validate == nil && len(f.validationList) > 0 {
-				if _, ok := f.validationList[t]; !ok {
-					return fmt.Errorf("%v is not an acceptable value for --%s. You must pick a value from %s.", t.Format(layout), f.long, f.getValidRangeTime(layout))
-				}
+			if err := f.validateValue(t, layout); err != nil {
+				return err
 			}
 
 			f.set(t)
@@ -328,6 +380,31 @@ func (f *TimeFlag) Set(value string) error {
 	return internal.InvalidValueErr(value, f.long, f.short, f.Type())
 }
 
+// validateValue checks the value against the validation rules of the flag.
+//
+// The layout will be used to format the values in the error messages.
+func (f *TimeFlag) validateValue(t time.Time, layout string) error {
+	if f.validate != nil {
+		err := f.validate(t)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := applyValidators(t, f.validators, f.long, f.short); err != nil {
+		return err
+	}
+
+	// Validation callback takes priority over validation list
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[t]; !ok {
+			return internal.NewMessageErr(internal.MsgOutOfRangeTime, t.Format(layout), f.long, f.getValidRangeTime(layout))
+		}
+	}
+
+	return nil
+}
+
 // ResetToDefault resets the value of this flag to default if a default value is specified.
 //
 // Calling this method on a flag without a default value will have no effect.
@@ -351,6 +428,14 @@ func (f *TimeFlag) Default() interface{} {
 	return f.defaultValue
 }
 
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *TimeFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	return f.validateValue(f.defaultValue, layouts[0])
+}
+
 // Key returns the current key of the flag.
 //
 // Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
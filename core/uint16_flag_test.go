@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
 )
 
 func TestUInt16(t *testing.T) {
@@ -490,6 +491,104 @@ func TestUInt16Flag_Validation(t *testing.T) {
 	}
 }
 
+func TestUInt16Flag_Bounds(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.UInt16Flag
+		value         string
+		expectedValue uint16
+		expectedRange string
+		expectedError string
+	}{
+		{
+			title:         "unbounded flag",
+			flag:          flags.UInt16("long", "usage"),
+			value:         "9",
+			expectedValue: 9,
+		},
+		{
+			title:         "value equal to the inclusive lower bound",
+			flag:          flags.UInt16("long", "usage").WithMin(10),
+			value:         "10",
+			expectedValue: 10,
+			expectedRange: ">= 10",
+		},
+		{
+			title:         "value less than the lower bound",
+			flag:          flags.UInt16("long", "usage").WithMin(10),
+			value:         "9",
+			expectedRange: ">= 10",
+			expectedError: "9 is not an acceptable value for --long. The expected range is >= 10.",
+		},
+		{
+			title:         "value equal to the exclusive lower bound",
+			flag:          flags.UInt16("long", "usage").WithMin(10, core.Exclusive),
+			value:         "10",
+			expectedRange: "> 10",
+			expectedError: "10 is not an acceptable value for --long. The expected range is > 10.",
+		},
+		{
+			title:         "value equal to the inclusive upper bound",
+			flag:          flags.UInt16("long", "usage").WithMax(20),
+			value:         "20",
+			expectedValue: 20,
+			expectedRange: "<= 20",
+		},
+		{
+			title:         "value greater than the upper bound",
+			flag:          flags.UInt16("long", "usage").WithMax(20),
+			value:         "21",
+			expectedRange: "<= 20",
+			expectedError: "21 is not an acceptable value for --long. The expected range is <= 20.",
+		},
+		{
+			title:         "value equal to the exclusive upper bound",
+			flag:          flags.UInt16("long", "usage").WithMax(20, core.Exclusive),
+			value:         "20",
+			expectedRange: "< 20",
+			expectedError: "20 is not an acceptable value for --long. The expected range is < 20.",
+		},
+		{
+			title:         "value within the closed range",
+			flag:          flags.UInt16("long", "usage").WithBetween(10, 20),
+			value:         "15",
+			expectedValue: 15,
+			expectedRange: "[10, 20]",
+		},
+		{
+			title:         "value equal to the bound of the open range",
+			flag:          flags.UInt16("long", "usage").WithBetween(10, 20, core.Exclusive),
+			value:         "20",
+			expectedRange: "(10, 20)",
+			expectedError: "20 is not an acceptable value for --long. The expected range is (10, 20).",
+		},
+		{
+			title:         "value within the half-open range",
+			flag:          flags.UInt16("long", "usage").WithMin(10).WithMax(20, core.Exclusive),
+			value:         "10",
+			expectedValue: 10,
+			expectedRange: "[10, 20)",
+		},
+		{
+			title:         "validation callback takes priority over the bounds",
+			flag:          flags.UInt16("long", "usage").WithBetween(10, 20).WithValidationCallback(func(in uint16) error { return nil }),
+			value:         "30",
+			expectedValue: 30,
+			expectedRange: "[10, 20]",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			checkFlag(t, tc.flag, err, tc.expectedError, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+			if actual := tc.flag.Range().String(); actual != tc.expectedRange {
+				t.Errorf("Expected Range: %s, Actual: %s", tc.expectedRange, actual)
+			}
+		})
+	}
+}
+
 func TestUInt16Flag_ResetToDefault(t *testing.T) {
 	testCases := []struct {
 		title                   string
@@ -0,0 +1,145 @@
+package core
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// MaskedValue is the value which will be displayed instead of the sensitive query values.
+const MaskedValue = "xxxxx"
+
+// urlRules holds the declarative validators of the URL flags.
+type urlRules struct {
+	schemes      []string
+	requireHost  bool
+	defaultPorts map[string]string
+	sensitive    bool
+	// sensitiveKeys the query parameters whose values must be masked. All the values will be masked if empty.
+	sensitiveKeys map[string]interface{}
+}
+
+func (r *urlRules) setSchemes(schemes []string) {
+	r.schemes = make([]string, 0)
+	for _, s := range schemes {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if !internal.IsEmpty(s) {
+			r.schemes = append(r.schemes, s)
+		}
+	}
+}
+
+func (r *urlRules) setDefaultPort(scheme string, port uint16) {
+	if r.defaultPorts == nil {
+		r.defaultPorts = make(map[string]string)
+	}
+	r.defaultPorts[strings.ToLower(strings.TrimSpace(scheme))] = strconv.Itoa(int(port))
+}
+
+func (r *urlRules) setSensitive(keys []string) {
+	r.sensitive = true
+	if r.sensitiveKeys == nil {
+		r.sensitiveKeys = make(map[string]interface{})
+	}
+	for _, k := range keys {
+		r.sensitiveKeys[k] = nil
+	}
+}
+
+// parse parses the value and injects the default port of the scheme (if specified).
+func (r *urlRules) parse(value string) (*url.URL, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	if port, ok := r.defaultPorts[u.Scheme]; ok && u.Host != "" && u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+	return u, nil
+}
+
+// check validates the scheme and the host of the URL.
+//
+// The masked input will be used in the error messages.
+func (r *urlRules) check(u *url.URL, input, long, short string) error {
+	name := internal.GetPrintName(long, short)
+	if len(r.schemes) > 0 {
+		var found bool
+		for _, s := range r.schemes {
+			if s == u.Scheme {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return internal.NewMessageErr(internal.MsgInvalidScheme, r.mask(input), name, strings.Join(r.schemes, ", "))
+		}
+	}
+	if r.requireHost && u.Hostname() == "" {
+		return internal.NewMessageErr(internal.MsgMissingHost, r.mask(input), name)
+	}
+	return nil
+}
+
+func (r *urlRules) constraints() []Constraint {
+	constraints := make([]Constraint, 0)
+	if len(r.schemes) > 0 {
+		constraints = append(constraints, NewConstraint(MsgSchemes, strings.Join(r.schemes, ", ")))
+	}
+	if r.requireHost {
+		constraints = append(constraints, NewConstraint(MsgHostRequired))
+	}
+	return constraints
+}
+
+// mask replaces the sensitive query values of the raw URL with MaskedValue.
+//
+// The raw value does not need to be a valid URL, so that the invalid inputs can also be masked in the error messages.
+func (r *urlRules) mask(raw string) string {
+	if !r.sensitive {
+		return raw
+	}
+	start := strings.Index(raw, "?")
+	if start < 0 {
+		return raw
+	}
+	query, fragment := raw[start+1:], ""
+	if end := strings.Index(query, "#"); end >= 0 {
+		query, fragment = query[:end], query[end:]
+	}
+	return raw[:start+1] + r.maskQuery(query) + fragment
+}
+
+// maskURL returns a copy of the URL with the sensitive query values masked.
+func (r *urlRules) maskURL(u *url.URL) *url.URL {
+	if !r.sensitive || u == nil {
+		return u
+	}
+	masked := *u
+	masked.RawQuery = r.maskQuery(u.RawQuery)
+	return &masked
+}
+
+func (r *urlRules) maskQuery(query string) string {
+	if query == "" {
+		return query
+	}
+	params := strings.Split(query, "&")
+	for i, p := range params {
+		eq := strings.Index(p, "=")
+		if eq < 0 {
+			continue
+		}
+		key := p[:eq]
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		if _, ok := r.sensitiveKeys[key]; ok || len(r.sensitiveKeys) == 0 {
+			params[i] = p[:eq+1] + MaskedValue
+		}
+	}
+	return strings.Join(params, "&")
+}
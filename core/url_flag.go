@@ -0,0 +1,411 @@
+package core
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// URLFlag represents a URL flag.
+//
+// The value of a URL flag can be specified using any URL string which can be parsed by url.Parse (i.e. "https://example.com:8443/api").
+// The acceptable schemes, the host requirement and the default ports can be defined using the builder methods.
+type URLFlag struct {
+	key                 *Key
+	defaultValue, value *url.URL
+	hasDefault          bool
+	ptr                 **url.URL
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	validate            func(in *url.URL) error
+	validationList      map[string]interface{}
+	acceptableItems     []string
+	rules               urlRules
+	validators          []Validator
+}
+
+// NewURL creates a new URL flag.
+func NewURL(name, usage string) *URLFlag {
+	f := &URLFlag{
+		key:   &Key{},
+		long:  internal.SanitiseLongName(name),
+		usage: usage,
+		ptr:   new(*url.URL),
+	}
+	f.set(nil)
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --server-url).
+func (f *URLFlag) LongName() string {
+	return f.long
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -u).
+func (f *URLFlag) WithShort(short string) *URLFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *URLFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *URLFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *URLFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *URLFlag) Required() *URLFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *URLFlag) Type() string {
+	return "url"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -U).
+func (f *URLFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *URLFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *URLFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *URLFlag) Var() **url.URL {
+	return f.ptr
+}
+
+// Get returns the current value of the flag, or nil if the flag has not been set.
+func (f *URLFlag) Get() *url.URL {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *URLFlag) WithKey(keyID string) *URLFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *URLFlag) WithDefault(defaultValue *url.URL) *URLFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *URLFlag) Hide() *URLFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *URLFlag) WithGroup(name string) *URLFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *URLFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *URLFlag) WithDescription(description string) *URLFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *URLFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *URLFlag) WithExamples(examples ...string) *URLFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *URLFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *URLFlag) MarkAsDeprecated(options ...DeprecationOption) *URLFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *URLFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// You can also define a list of acceptable values using WithValidRange(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *URLFlag) WithValidationCallback(validate func(in *url.URL) error) *URLFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *URLFlag) WithValidators(validators ...Validator) *URLFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *URLFlag) Constraints() []Constraint {
+	return append(f.rules.constraints(), describeValidators(f.validators)...)
+}
+
+// WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
+//
+// The set operation will fail if the flag value is not from the specified list.
+// You can also define a custom validation callback function using WithValidationCallback(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+//
+// The URLs will be compared using their string representation, after the default port (if any) has been injected.
+func (f *URLFlag) WithValidRange(valid ...*url.URL) *URLFlag {
+	if len(valid) == 0 {
+		return f
+	}
+	f.validationList = make(map[string]interface{})
+	f.acceptableItems = make([]string, 0)
+	for _, v := range valid {
+		if v == nil {
+			continue
+		}
+		s := v.String()
+		if _, ok := f.validationList[s]; !ok {
+			f.validationList[s] = nil
+			f.acceptableItems = append(f.acceptableItems, f.rules.maskURL(v).String())
+		}
+	}
+	return f
+}
+
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *URLFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithSchemes defines the list of acceptable schemes (i.e. WithSchemes("https", "grpc")).
+//
+// The schemes are case insensitive.
+// Remember that setting the schemes will have no effect if a validation callback has been specified.
+func (f *URLFlag) WithSchemes(schemes ...string) *URLFlag {
+	f.rules.setSchemes(schemes)
+	return f
+}
+
+// RequireHost rejects the URLs without a host (i.e. "file:///etc/hosts").
+//
+// Remember that requiring the host will have no effect if a validation callback has been specified.
+func (f *URLFlag) RequireHost() *URLFlag {
+	f.rules.requireHost = true
+	return f
+}
+
+// WithDefaultPort sets the port which will be injected into the URLs of the specified scheme, if no port has been provided.
+//
+// For example, "grpc://example.com" will be resolved to "grpc://example.com:50051" by calling WithDefaultPort("grpc", 50051).
+func (f *URLFlag) WithDefaultPort(scheme string, port uint16) *URLFlag {
+	f.rules.setDefaultPort(scheme, port)
+	return f
+}
+
+// WithSensitiveQuery marks the values of the specified query parameters as sensitive (i.e. WithSensitiveQuery("token")).
+//
+// The sensitive values will be replaced with core.MaskedValue in the help output and the error messages.
+// All the query values will be masked if no parameter has been specified.
+func (f *URLFlag) WithSensitiveQuery(keys ...string) *URLFlag {
+	f.rules.setSensitive(keys)
+	return f
+}
+
+// MaskValue returns the value with the sensitive query values masked.
+func (f *URLFlag) MaskValue(value string) string {
+	return f.rules.mask(value)
+}
+
+// Set sets the flag value.
+//
+// The value of a URL flag can be specified using any URL string which can be parsed by url.Parse (i.e. "https://example.com:8443/api").
+func (f *URLFlag) Set(value string) error {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		f.set(nil)
+		f.isSet = true
+		return nil
+	}
+	u, err := f.rules.parse(value)
+	if err != nil {
+		return internal.InvalidValueErr(f.rules.mask(value), f.long, f.short, f.Type())
+	}
+
+	if err := f.validateValue(u, value); err != nil {
+		return err
+	}
+
+	f.set(u)
+	f.isSet = true
+	return nil
+}
+
+// validateValue checks the value against the validation rules of the flag.
+//
+// The input will be masked and used in the error messages.
+func (f *URLFlag) validateValue(v *url.URL, input string) error {
+	if f.validate != nil {
+		err := f.validate(v)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, validator := range f.validators {
+		if err := validator.Validate(v); err != nil {
+			return validationErr(f.rules.mask(input), f.long, f.short, err)
+		}
+	}
+
+	// Validation callback takes priority over validation list and the declarative rules
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[v.String()]; !ok {
+			return internal.OutOfRangeErr(f.rules.mask(input), f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil {
+		return f.rules.check(v, input, f.long, f.short)
+	}
+
+	return nil
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *URLFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	f.set(f.defaultValue)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The sensitive query values of the returned URL will be masked (See WithSensitiveQuery(...)).
+// The default value can be defined using WithDefault(...) method.
+func (f *URLFlag) Default() interface{} {
+	if !f.hasDefault || f.defaultValue == nil {
+		return nil
+	}
+
+	return f.rules.maskURL(f.defaultValue)
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *URLFlag) ValidateDefault() error {
+	if !f.hasDefault || f.defaultValue == nil {
+		return nil
+	}
+	return f.validateValue(f.defaultValue, f.defaultValue.String())
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *URLFlag) Key() *Key {
+	return f.key
+}
+
+func (f *URLFlag) set(value *url.URL) {
+	f.value = value
+	*f.ptr = value
+}
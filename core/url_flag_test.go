@@ -0,0 +1,340 @@
+package core_test
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+	"github.com/xitonix/flags/validate"
+)
+
+func mustParseURL(t *testing.T, raw string) *url.URL {
+	t.Helper()
+	u, err := url.Parse(raw)
+	if err != nil {
+		t.Fatalf("Failed to parse %s: %s", raw, err)
+	}
+	return u
+}
+
+func checkURL(t *testing.T, expected string, actual *url.URL, actualVar **url.URL) {
+	t.Helper()
+	var str string
+	if actual != nil {
+		str = actual.String()
+	}
+	if str != expected {
+		t.Errorf("Expected value: %s, Actual: %s", expected, str)
+	}
+	var varStr string
+	if *actualVar != nil {
+		varStr = (*actualVar).String()
+	}
+	if varStr != expected {
+		t.Errorf("Expected flag variable: %s, Actual: %s", expected, varStr)
+	}
+}
+
+func TestURL(t *testing.T) {
+	testCases := []struct {
+		title         string
+		long          string
+		expectedLong  string
+		usage         string
+		expectedUsage string
+	}{
+		{
+			title:         "lowercase long name with usage",
+			long:          "long",
+			expectedLong:  "long",
+			usage:         "usage",
+			expectedUsage: "usage",
+		},
+		{
+			title:         "uppercase long name with usage",
+			long:          "LONG",
+			expectedLong:  "long",
+			usage:         " I must Stay Unchanged   ",
+			expectedUsage: " I must Stay Unchanged   ",
+		},
+		{
+			title:         "long name with white space",
+			long:          "   long  ",
+			expectedLong:  "long",
+			usage:         "     ",
+			expectedUsage: "     ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := flags.URL(tc.long, tc.usage)
+			checkFlagInitialState(t, f, "url", tc.expectedUsage, tc.expectedLong, "")
+			checkURL(t, "", f.Get(), f.Var())
+		})
+	}
+}
+
+func TestURLFlag_WithDefault(t *testing.T) {
+	testCases := []struct {
+		title           string
+		flag            *core.URLFlag
+		defaultValue    string
+		expectedDefault string
+	}{
+		{
+			title:           "plain default value",
+			flag:            flags.URL("long", "usage"),
+			defaultValue:    "https://example.com?token=secret",
+			expectedDefault: "https://example.com?token=secret",
+		},
+		{
+			title:           "sensitive query parameter",
+			flag:            flags.URL("long", "usage").WithSensitiveQuery("token"),
+			defaultValue:    "https://example.com?region=eu&token=secret",
+			expectedDefault: "https://example.com?region=eu&token=xxxxx",
+		},
+		{
+			title:           "all the query parameters are sensitive",
+			flag:            flags.URL("long", "usage").WithSensitiveQuery(),
+			defaultValue:    "https://example.com?region=eu&token=secret",
+			expectedDefault: "https://example.com?region=xxxxx&token=xxxxx",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			f := tc.flag.WithDefault(mustParseURL(t, tc.defaultValue))
+			actual := f.Default().(*url.URL).String()
+			if actual != tc.expectedDefault {
+				t.Errorf("Expected Default Value: %s, Actual: %s", tc.expectedDefault, actual)
+			}
+			f.ResetToDefault()
+			if actual := f.Get().String(); actual != tc.defaultValue {
+				t.Errorf("Expected the unmasked value after reset: %s, Actual: %s", tc.defaultValue, actual)
+			}
+		})
+	}
+}
+
+func TestURLFlag_Set(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.URLFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title: "no value",
+			flag:  flags.URL("long", "usage"),
+		},
+		{
+			title:         "value with white space",
+			flag:          flags.URL("long", "usage"),
+			value:         "  https://example.com/api  ",
+			expectedValue: "https://example.com/api",
+		},
+		{
+			title:         "invalid value",
+			flag:          flags.URL("long", "usage"),
+			value:         "http://[::1",
+			expectedError: "'http://[::1' is not a valid url value for --long",
+		},
+		{
+			title:         "acceptable scheme",
+			flag:          flags.URL("long", "usage").WithSchemes("HTTPS", "grpc"),
+			value:         "GRPC://example.com",
+			expectedValue: "grpc://example.com",
+		},
+		{
+			title:         "unacceptable scheme",
+			flag:          flags.URL("long", "usage").WithSchemes("https", "grpc"),
+			value:         "http://example.com",
+			expectedError: "'http://example.com' is not an acceptable value for --long. The scheme must be one of https, grpc.",
+		},
+		{
+			title:         "missing host",
+			flag:          flags.URL("long", "usage").RequireHost(),
+			value:         "file:///etc/hosts",
+			expectedError: "'file:///etc/hosts' is not an acceptable value for --long. The host cannot be empty.",
+		},
+		{
+			title:         "relative reference without host",
+			flag:          flags.URL("long", "usage").WithShort("u").RequireHost(),
+			value:         "example.com/api",
+			expectedError: "'example.com/api' is not an acceptable value for -u, --long. The host cannot be empty.",
+		},
+		{
+			title:         "default port injection",
+			flag:          flags.URL("long", "usage").WithDefaultPort("grpc", 50051),
+			value:         "grpc://example.com/service",
+			expectedValue: "grpc://example.com:50051/service",
+		},
+		{
+			title:         "default port injection with IPv6 host",
+			flag:          flags.URL("long", "usage").WithDefaultPort("https", 443),
+			value:         "https://[::1]/api",
+			expectedValue: "https://[::1]:443/api",
+		},
+		{
+			title:         "explicit port takes priority over the default port",
+			flag:          flags.URL("long", "usage").WithDefaultPort("grpc", 50051),
+			value:         "grpc://example.com:9000",
+			expectedValue: "grpc://example.com:9000",
+		},
+		{
+			title:         "default port of a different scheme",
+			flag:          flags.URL("long", "usage").WithDefaultPort("grpc", 50051),
+			value:         "https://example.com",
+			expectedValue: "https://example.com",
+		},
+		{
+			title:         "sensitive query values are masked in the errors",
+			flag:          flags.URL("long", "usage").WithSchemes("https").WithSensitiveQuery("token"),
+			value:         "http://example.com?token=secret&region=eu#top",
+			expectedError: "'http://example.com?token=xxxxx&region=eu#top' is not an acceptable value for --long. The scheme must be one of https.",
+		},
+		{
+			title:         "sensitive query values of the invalid values are masked",
+			flag:          flags.URL("long", "usage").WithSensitiveQuery(),
+			value:         "http://[::1?token=secret",
+			expectedError: "'http://[::1?token=xxxxx' is not a valid url value for --long",
+		},
+		{
+			title:         "sensitive query values are not masked in the flag value",
+			flag:          flags.URL("long", "usage").WithSensitiveQuery("token"),
+			value:         "https://example.com?token=secret",
+			expectedValue: "https://example.com?token=secret",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			if tc.expectedError == "" && !tc.flag.IsSet() {
+				t.Error("IsSet(), Expected value: true, Actual: false")
+			}
+			checkURL(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestURLFlag_Validation(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.URLFlag
+		value         string
+		expectedValue string
+		expectedError string
+	}{
+		{
+			title:         "value from the validation list",
+			flag:          flags.URL("long", "usage").WithValidRange(&url.URL{Scheme: "https", Host: "a.com"}, &url.URL{Scheme: "https", Host: "b.com"}),
+			value:         "https://b.com",
+			expectedValue: "https://b.com",
+		},
+		{
+			title:         "value not from the validation list",
+			flag:          flags.URL("long", "usage").WithValidRange(&url.URL{Scheme: "https", Host: "a.com"}, &url.URL{Scheme: "https", Host: "b.com"}),
+			value:         "https://c.com",
+			expectedError: "https://c.com is not an acceptable value for --long. The expected values are https://a.com,https://b.com.",
+		},
+		{
+			title: "validation callback takes priority over the declarative rules",
+			flag: flags.URL("long", "usage").WithSchemes("https").RequireHost().WithValidationCallback(func(in *url.URL) error {
+				return nil
+			}),
+			value:         "file:///etc/hosts",
+			expectedValue: "file:///etc/hosts",
+		},
+		{
+			title: "validation callback error",
+			flag: flags.URL("long", "usage").WithValidationCallback(func(in *url.URL) error {
+				return errors.New("validation callback failed")
+			}),
+			value:         "https://example.com",
+			expectedError: "validation callback failed",
+		},
+		{
+			title:         "sensitive query values are masked in the validator errors",
+			flag:          flags.URL("long", "usage").WithSensitiveQuery("token").WithValidators(validate.Pattern(regexp.MustCompile(`^https://`))),
+			value:         "http://example.com?token=secret",
+			expectedError: "http://example.com?token=xxxxx is not an acceptable value for --long: the value must match the ^https:// pattern.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			checkURL(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestURLFlag_Constraints(t *testing.T) {
+	f := flags.URL("long", "usage").WithSchemes("https", "grpc").RequireHost()
+	constraints := make([]string, 0)
+	for _, c := range f.Constraints() {
+		constraints = append(constraints, c.String())
+	}
+	expected := "schemes: https, grpc, host required"
+	if actual := strings.Join(constraints, ", "); actual != expected {
+		t.Errorf("Expected Constraints: %s, Actual: %s", expected, actual)
+	}
+}
+
+func TestURLFlag_MaskValue(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     *core.URLFlag
+		value    string
+		expected string
+	}{
+		{
+			title:    "not sensitive",
+			flag:     flags.URL("long", "usage"),
+			value:    "https://example.com?token=secret",
+			expected: "https://example.com?token=secret",
+		},
+		{
+			title:    "no query",
+			flag:     flags.URL("long", "usage").WithSensitiveQuery(),
+			value:    "https://example.com#token=secret",
+			expected: "https://example.com#token=secret",
+		},
+		{
+			title:    "escaped key",
+			flag:     flags.URL("long", "usage").WithSensitiveQuery("api key"),
+			value:    "https://example.com?api%20key=secret&api+key=secret&key=value&flag",
+			expected: "https://example.com?api%20key=xxxxx&api+key=xxxxx&key=value&flag",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			if actual := tc.flag.MaskValue(tc.value); actual != tc.expected {
+				t.Errorf("Expected: %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestURLFlag_ValidateDefault(t *testing.T) {
+	f := flags.URL("long", "usage").WithSchemes("https").WithSensitiveQuery().WithDefault(&url.URL{Scheme: "http", Host: "example.com", RawQuery: "token=secret"})
+	expected := "'http://example.com?token=xxxxx' is not an acceptable value for --long. The scheme must be one of https."
+	if err := f.ValidateDefault(); !test.ErrorContainsExact(err, expected) {
+		t.Errorf("Expected to receive '%s', but received %s", expected, err)
+	}
+}
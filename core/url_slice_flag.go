@@ -0,0 +1,478 @@
+package core
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// URLSliceFlag represents a URL slice flag.
+//
+// The value of a URL slice flag can be specified using a comma (or any custom delimiter) separated string of URLs
+// (i.e. "https://a.example.com, grpc://b.example.com:50051").
+type URLSliceFlag struct {
+	key                 *Key
+	defaultValue, value []*url.URL
+	hasDefault          bool
+	ptr                 *[]*url.URL
+	long, short         string
+	usage               string
+	isSet               bool
+	deprecation         *Deprecation
+	isRequired          bool
+	isHidden            bool
+	group               string
+	description         string
+	examples            []string
+	delimiter           string
+	validate            func(in *url.URL) error
+	validationList      map[string]interface{}
+	acceptableItems     []string
+	items               itemRules
+	rules               urlRules
+	validators          []Validator
+}
+
+// NewURLSlice creates a new URL slice flag.
+func NewURLSlice(name, usage string) *URLSliceFlag {
+	f := &URLSliceFlag{
+		key:       &Key{},
+		long:      internal.SanitiseLongName(name),
+		usage:     usage,
+		ptr:       new([]*url.URL),
+		delimiter: DefaultDelimiter,
+	}
+	f.set(nil)
+	return f
+}
+
+// LongName returns the long name of the flag.
+//
+// Long name is case insensitive and always lower case (i.e. --servers).
+func (f *URLSliceFlag) LongName() string {
+	return f.long
+}
+
+// WithShort sets the short name of the flag.
+//
+// The short name is a single case sensitive character (i.e. -s).
+func (f *URLSliceFlag) WithShort(short string) *URLSliceFlag {
+	f.short = internal.SanitiseShortName(short)
+	return f
+}
+
+// IsHidden returns true if the flag is hidden.
+//
+// A hidden flag won't be printed in the help output.
+func (f *URLSliceFlag) IsHidden() bool {
+	return f.isHidden
+}
+
+// IsDeprecated returns true if the flag is deprecated.
+func (f *URLSliceFlag) IsDeprecated() bool {
+	return f.deprecation != nil
+}
+
+// IsRequired returns true if the flag value must be provided.
+func (f *URLSliceFlag) IsRequired() bool {
+	return f.isRequired
+}
+
+// Required makes the flag mandatory.
+//
+// Setting the default value of a required flag will have no effect.
+func (f *URLSliceFlag) Required() *URLSliceFlag {
+	f.isRequired = true
+	return f
+}
+
+// Type returns the string representation of the flag's type.
+//
+// This will be printed in the help output.
+func (f *URLSliceFlag) Type() string {
+	return "[]url"
+}
+
+// ShortName returns the flag's short name.
+//
+// Short name is a single case sensitive character (i.e. -S).
+func (f *URLSliceFlag) ShortName() string {
+	return f.short
+}
+
+// Usage returns the usage string of the flag.
+//
+// This will be printed in the help output.
+func (f *URLSliceFlag) Usage() string {
+	return f.usage
+}
+
+// IsSet returns true if the value of this flag is set by one of the available sources.
+//
+// This method returns false if none of the sources has a value to offer, or the value
+// has been set to Default (if specified).
+func (f *URLSliceFlag) IsSet() bool {
+	return f.isSet
+}
+
+// Var returns a pointer to the underlying variable.
+//
+// You can also use the Get() method as an alternative.
+func (f *URLSliceFlag) Var() *[]*url.URL {
+	return f.ptr
+}
+
+// Get returns the current value of the flag.
+func (f *URLSliceFlag) Get() []*url.URL {
+	return f.value
+}
+
+// WithKey explicitly defines the key for this flag.
+//
+// Explicit keys will override the automatically generated values, defined at bucket level (if enabled).
+//
+// In order for the flag value to be extractable from the environment variables, or all the other custom sources,
+// it MUST have a key associated with it. You can set the key to "-" to disable the auto generated ID (if there is one) for this flag.
+func (f *URLSliceFlag) WithKey(keyID string) *URLSliceFlag {
+	f.key.SetID(keyID)
+	return f
+}
+
+// WithDefault sets the default value of the flag.
+//
+// If none of the available sources offers a value, the default value will be assigned to the flag.
+func (f *URLSliceFlag) WithDefault(defaultValue []*url.URL) *URLSliceFlag {
+	f.defaultValue = defaultValue
+	f.hasDefault = true
+	return f
+}
+
+// Hide marks the flag as hidden.
+//
+// A hidden flag will not be displayed in the help output.
+func (f *URLSliceFlag) Hide() *URLSliceFlag {
+	f.isHidden = true
+	return f
+}
+
+// WithGroup assigns the flag to a named group.
+//
+// The flags of each group will be printed under their own heading in the help output.
+// Ungrouped flags will be printed in the default section (See config.WithDefaultGroupTitle).
+func (f *URLSliceFlag) WithGroup(name string) *URLSliceFlag {
+	f.group = name
+	return f
+}
+
+// Group returns the name of the group to which the flag belongs, or an empty string if the flag has not been grouped.
+func (f *URLSliceFlag) Group() string {
+	return f.group
+}
+
+// WithDescription sets the long description of the flag.
+//
+// Unlike the one-line usage string, the description will only be printed on the help page of the flag (i.e. --help=name).
+func (f *URLSliceFlag) WithDescription(description string) *URLSliceFlag {
+	f.description = description
+	return f
+}
+
+// Description returns the long description of the flag.
+func (f *URLSliceFlag) Description() string {
+	return f.description
+}
+
+// WithExamples sets the usage examples of the flag, which will be printed on the help page of the flag (i.e. --help=name).
+func (f *URLSliceFlag) WithExamples(examples ...string) *URLSliceFlag {
+	f.examples = examples
+	return f
+}
+
+// Examples returns the usage examples of the flag.
+func (f *URLSliceFlag) Examples() []string {
+	return f.examples
+}
+
+// MarkAsDeprecated marks the flag as deprecated.
+//
+// A deprecated flag will be marked in the help output to draw the users' attention.
+// The default deprecation mark (config.DeprecatedFlagIndicatorDefault) can be overridden by configuring the bucket.
+//
+// A warning will be reported to the bucket's logger if the value of a deprecated flag is provided by any of the sources.
+// The warning can be customised using core.WithDeprecationMessage(...) and core.WithReplacement(...) options.
+//
+// Example:
+//
+// 	flags.SetDeprecationMark("**DEPRECATED**")
+//  OR
+// 	bucket := flags.NewBucket(config.WithDeprecationMark("**DEPRECATED**"))
+func (f *URLSliceFlag) MarkAsDeprecated(options ...DeprecationOption) *URLSliceFlag {
+	f.deprecation = newDeprecation(options)
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag or nil if the flag is not deprecated.
+func (f *URLSliceFlag) Deprecation() *Deprecation {
+	return f.deprecation
+}
+
+// WithDelimiter sets the delimiter for splitting the input string (Default: core.DefaultDelimiter).
+func (f *URLSliceFlag) WithDelimiter(delimiter string) *URLSliceFlag {
+	if len(delimiter) == 0 {
+		delimiter = DefaultDelimiter
+	}
+	f.delimiter = delimiter
+	return f
+}
+
+// WithValidationCallback sets the validation callback function which will be called when the flag value is being set.
+//
+// The set operation will fail if the callback returns an error.
+// You can also define a list of acceptable values using WithValidRange(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+func (f *URLSliceFlag) WithValidationCallback(validate func(in *url.URL) error) *URLSliceFlag {
+	f.validate = validate
+	return f
+}
+
+// WithValidators adds the reusable validators which will be called when the flag value is being set (See the validate package).
+//
+// The set operation will fail if any of the validators rejects the value.
+// The validators will be applied to each item of the list.
+// Unlike the validation callback, the validators do not override the other validation rules of the flag.
+func (f *URLSliceFlag) WithValidators(validators ...Validator) *URLSliceFlag {
+	f.validators = append(f.validators, validators...)
+	return f
+}
+
+// WithValidRange defines a list of acceptable values from which the final flag value can be chosen.
+//
+// The set operation will fail if the flag value is not from the specified list.
+// You can also define a custom validation callback function using WithValidationCallback(...) method.
+// Remember that setting the valid range will have no effect if a validation callback has been specified.
+//
+// The URLs will be compared using their string representation, after the default port (if any) has been injected.
+func (f *URLSliceFlag) WithValidRange(valid ...*url.URL) *URLSliceFlag {
+	if len(valid) == 0 {
+		return f
+	}
+	f.validationList = make(map[string]interface{})
+	f.acceptableItems = make([]string, 0)
+	for _, v := range valid {
+		if v == nil {
+			continue
+		}
+		s := v.String()
+		if _, ok := f.validationList[s]; !ok {
+			f.acceptableItems = append(f.acceptableItems, f.rules.maskURL(v).String())
+			f.validationList[s] = nil
+		}
+	}
+	return f
+}
+
+// ValidRange returns the list of acceptable values for the flag (if specified).
+//
+// The list can be defined using WithValidRange(...) method.
+func (f *URLSliceFlag) ValidRange() []string {
+	return f.acceptableItems
+}
+
+// WithMinItems sets the minimum number of the items in the list (inclusive).
+func (f *URLSliceFlag) WithMinItems(min int) *URLSliceFlag {
+	f.items.count.setMin(int64(min), nil)
+	return f
+}
+
+// WithMaxItems sets the maximum number of the items in the list (inclusive).
+func (f *URLSliceFlag) WithMaxItems(max int) *URLSliceFlag {
+	f.items.count.setMax(int64(max), nil)
+	return f
+}
+
+// Unique rejects the lists with duplicate items.
+func (f *URLSliceFlag) Unique() *URLSliceFlag {
+	f.items.unique = true
+	return f
+}
+
+// WithSchemes defines the list of acceptable schemes (i.e. WithSchemes("https", "grpc")).
+//
+// The schemes are case insensitive.
+// Remember that setting the schemes will have no effect if a validation callback has been specified.
+func (f *URLSliceFlag) WithSchemes(schemes ...string) *URLSliceFlag {
+	f.rules.setSchemes(schemes)
+	return f
+}
+
+// RequireHost rejects the URLs without a host (i.e. "file:///etc/hosts").
+//
+// Remember that requiring the host will have no effect if a validation callback has been specified.
+func (f *URLSliceFlag) RequireHost() *URLSliceFlag {
+	f.rules.requireHost = true
+	return f
+}
+
+// WithDefaultPort sets the port which will be injected into the URLs of the specified scheme, if no port has been provided.
+//
+// For example, "grpc://example.com" will be resolved to "grpc://example.com:50051" by calling WithDefaultPort("grpc", 50051).
+func (f *URLSliceFlag) WithDefaultPort(scheme string, port uint16) *URLSliceFlag {
+	f.rules.setDefaultPort(scheme, port)
+	return f
+}
+
+// WithSensitiveQuery marks the values of the specified query parameters as sensitive (i.e. WithSensitiveQuery("token")).
+//
+// The sensitive values will be replaced with core.MaskedValue in the help output and the error messages.
+// All the query values will be masked if no parameter has been specified.
+func (f *URLSliceFlag) WithSensitiveQuery(keys ...string) *URLSliceFlag {
+	f.rules.setSensitive(keys)
+	return f
+}
+
+// MaskValue returns the value with the sensitive query values masked.
+func (f *URLSliceFlag) MaskValue(value string) string {
+	parts := strings.Split(value, f.delimiter)
+	for i, p := range parts {
+		parts[i] = f.rules.mask(p)
+	}
+	return strings.Join(parts, f.delimiter)
+}
+
+// Constraints returns the restrictions on the flag value, defined by the declarative validators.
+func (f *URLSliceFlag) Constraints() []Constraint {
+	constraints := append(f.items.constraints(), f.rules.constraints()...)
+	return append(constraints, describeValidators(f.validators)...)
+}
+
+// Set sets the flag value.
+//
+// The value of a URL slice flag can be specified using a comma (or any custom delimiter) separated string of URLs
+// (i.e. "https://a.example.com, grpc://b.example.com:50051").
+func (f *URLSliceFlag) Set(value string) error {
+	parts := strings.Split(strings.TrimSpace(value), f.delimiter)
+	list := make([]*url.URL, 0)
+	for _, v := range parts {
+		value = strings.TrimSpace(v)
+		if internal.IsEmpty(v) {
+			continue
+		}
+		u, err := f.rules.parse(value)
+		if err != nil {
+			return internal.InvalidValueErr(f.rules.mask(value), f.long, f.short, f.Type())
+		}
+
+		if err := f.validateItem(u, value); err != nil {
+			return err
+		}
+
+		list = append(list, u)
+	}
+
+	if err := f.validateItems(list); err != nil {
+		return err
+	}
+
+	f.set(list)
+	f.isSet = true
+	return nil
+}
+
+// validateItem checks the item against the validation rules of the flag.
+//
+// The input will be masked and used in the error messages.
+func (f *URLSliceFlag) validateItem(item *url.URL, input string) error {
+	if f.validate != nil {
+		err := f.validate(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, validator := range f.validators {
+		if err := validator.Validate(item); err != nil {
+			return validationErr(f.rules.mask(input), f.long, f.short, err)
+		}
+	}
+
+	// Validation callback takes priority over validation list and the declarative rules
+	if f.validate == nil && len(f.validationList) > 0 {
+		if _, ok := f.validationList[item.String()]; !ok {
+			return internal.OutOfRangeErr(f.rules.mask(input), f.long, f.short, f.acceptableItems)
+		}
+	}
+	if f.validate == nil {
+		return f.rules.check(item, input, f.long, f.short)
+	}
+
+	return nil
+}
+
+// validateItems checks the number of the items and their uniqueness.
+func (f *URLSliceFlag) validateItems(list []*url.URL) error {
+	return f.items.check(f.long, f.short, len(list), func(i int) string {
+		return f.rules.maskURL(list[i]).String()
+	})
+}
+
+// ResetToDefault resets the value of this flag to default if a default value is specified.
+//
+// Calling this method on a flag without a default value will have no effect.
+// The default value can be defined using WithDefault(...) method.
+func (f *URLSliceFlag) ResetToDefault() {
+	if !f.hasDefault {
+		return
+	}
+	f.isSet = false
+	f.set(f.defaultValue)
+}
+
+// Default returns the default value if specified, otherwise returns nil.
+//
+// The sensitive query values of the returned URLs will be masked (See WithSensitiveQuery(...)).
+// The default value can be defined using WithDefault(...) method.
+func (f *URLSliceFlag) Default() interface{} {
+	if !f.hasDefault {
+		return nil
+	}
+
+	if !f.rules.sensitive || f.defaultValue == nil {
+		return f.defaultValue
+	}
+	masked := make([]*url.URL, len(f.defaultValue))
+	for i, u := range f.defaultValue {
+		masked[i] = f.rules.maskURL(u)
+	}
+	return masked
+}
+
+// ValidateDefault checks the default value of the flag (if specified) against the validation rules of the flag.
+func (f *URLSliceFlag) ValidateDefault() error {
+	if !f.hasDefault {
+		return nil
+	}
+	for _, item := range f.defaultValue {
+		if item == nil {
+			continue
+		}
+		if err := f.validateItem(item, item.String()); err != nil {
+			return err
+		}
+	}
+	return f.validateItems(f.defaultValue)
+}
+
+// Key returns the current key of the flag.
+//
+// Each flag within a bucket may have an optional UNIQUE key which will be used to retrieve its value
+// from different sources. This is the key which will be used internally to retrieve the flag's value
+// from the environment variables.
+func (f *URLSliceFlag) Key() *Key {
+	return f.key
+}
+
+func (f *URLSliceFlag) set(value []*url.URL) {
+	f.value = value
+	*f.ptr = value
+}
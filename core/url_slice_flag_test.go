@@ -0,0 +1,146 @@
+package core_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/xitonix/flags"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/test"
+)
+
+func checkURLSlice(t *testing.T, expected []string, actual []*url.URL, actualVar *[]*url.URL) {
+	t.Helper()
+	toStrings := func(list []*url.URL) []string {
+		result := make([]string, len(list))
+		for i, u := range list {
+			result[i] = u.String()
+		}
+		return result
+	}
+	if a, e := strings.Join(toStrings(actual), ","), strings.Join(expected, ","); a != e {
+		t.Errorf("Expected value: %s, Actual: %s", e, a)
+	}
+	if a, e := strings.Join(toStrings(*actualVar), ","), strings.Join(expected, ","); a != e {
+		t.Errorf("Expected flag variable: %s, Actual: %s", e, a)
+	}
+}
+
+func TestURLSlice(t *testing.T) {
+	f := flags.URLSlice("LONG", "usage")
+	checkFlagInitialState(t, f, "[]url", "usage", "long", "")
+	checkURLSlice(t, nil, f.Get(), f.Var())
+}
+
+func TestURLSliceFlag_Set(t *testing.T) {
+	testCases := []struct {
+		title         string
+		flag          *core.URLSliceFlag
+		value         string
+		expectedValue []string
+		expectedError string
+	}{
+		{
+			title: "empty value",
+			flag:  flags.URLSlice("long", "usage"),
+		},
+		{
+			title:         "comma separated value with white space",
+			flag:          flags.URLSlice("long", "usage"),
+			value:         " https://a.com , grpc://b.com:9000 ,",
+			expectedValue: []string{"https://a.com", "grpc://b.com:9000"},
+		},
+		{
+			title:         "custom delimiter",
+			flag:          flags.URLSlice("long", "usage").WithDelimiter("|"),
+			value:         "https://a.com?x=1,2|https://b.com",
+			expectedValue: []string{"https://a.com?x=1,2", "https://b.com"},
+		},
+		{
+			title:         "partially invalid value",
+			flag:          flags.URLSlice("long", "usage"),
+			value:         "https://a.com,http://[::1",
+			expectedError: "'http://[::1' is not a valid []url value for --long",
+		},
+		{
+			title:         "default port injection",
+			flag:          flags.URLSlice("long", "usage").WithDefaultPort("grpc", 50051).WithDefaultPort("https", 443),
+			value:         "grpc://a.com,https://b.com,https://c.com:8443",
+			expectedValue: []string{"grpc://a.com:50051", "https://b.com:443", "https://c.com:8443"},
+		},
+		{
+			title:         "unacceptable scheme",
+			flag:          flags.URLSlice("long", "usage").WithSchemes("https"),
+			value:         "https://a.com,ftp://b.com",
+			expectedError: "'ftp://b.com' is not an acceptable value for --long. The scheme must be one of https.",
+		},
+		{
+			title:         "missing host",
+			flag:          flags.URLSlice("long", "usage").RequireHost(),
+			value:         "https://a.com,/relative/path",
+			expectedError: "'/relative/path' is not an acceptable value for --long. The host cannot be empty.",
+		},
+		{
+			title:         "sensitive query values are masked in the errors",
+			flag:          flags.URLSlice("long", "usage").WithSchemes("https").WithSensitiveQuery("token"),
+			value:         "https://a.com?token=a,http://b.com?token=b",
+			expectedError: "'http://b.com?token=xxxxx' is not an acceptable value for --long. The scheme must be one of https.",
+		},
+		{
+			title:         "duplicate items",
+			flag:          flags.URLSlice("long", "usage").WithDefaultPort("https", 443).Unique(),
+			value:         "https://a.com,https://a.com:443",
+			expectedError: "'https://a.com:443' has been provided more than once for --long. The items must be unique.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.flag.Set(tc.value)
+			if !test.ErrorContains(err, tc.expectedError) {
+				t.Errorf("Expected to receive an error with '%s', but received %s", tc.expectedError, err)
+			}
+			if tc.expectedError == "" && !tc.flag.IsSet() {
+				t.Error("IsSet(), Expected value: true, Actual: false")
+			}
+			checkURLSlice(t, tc.expectedValue, tc.flag.Get(), tc.flag.Var())
+		})
+	}
+}
+
+func TestURLSliceFlag_Default(t *testing.T) {
+	f := flags.URLSlice("long", "usage").WithSensitiveQuery("token").WithDefault([]*url.URL{
+		{Scheme: "https", Host: "a.com", RawQuery: "token=secret"},
+		{Scheme: "https", Host: "b.com"},
+	})
+	actual := core.FormatValue(f.Default())
+	expected := "https://a.com?token=xxxxx,https://b.com"
+	if actual != expected {
+		t.Errorf("Expected Default Value: %s, Actual: %s", expected, actual)
+	}
+
+	f.ResetToDefault()
+	checkURLSlice(t, []string{"https://a.com?token=secret", "https://b.com"}, f.Get(), f.Var())
+}
+
+func TestURLSliceFlag_MaskValue(t *testing.T) {
+	f := flags.URLSlice("long", "usage").WithDelimiter(";").WithSensitiveQuery("token")
+	actual := f.MaskValue("https://a.com?token=a&x=1;https://b.com?token=b")
+	expected := "https://a.com?token=xxxxx&x=1;https://b.com?token=xxxxx"
+	if actual != expected {
+		t.Errorf("Expected: %s, Actual: %s", expected, actual)
+	}
+}
+
+func TestURLSliceFlag_Constraints(t *testing.T) {
+	f := flags.URLSlice("long", "usage").WithMaxItems(3).WithSchemes("https").RequireHost()
+	constraints := make([]string, 0)
+	for _, c := range f.Constraints() {
+		constraints = append(constraints, c.String())
+	}
+	expected := "items: <= 3, schemes: https, host required"
+	if actual := strings.Join(constraints, ", "); actual != expected {
+		t.Errorf("Expected Constraints: %s, Actual: %s", expected, actual)
+	}
+}
@@ -0,0 +1,7 @@
+package core
+
+// ValidRangeProvider is the interface for the flags which can only accept a limited list of values.
+type ValidRangeProvider interface {
+	// ValidRange returns the list of acceptable values, or an empty list if any value is accepted.
+	ValidRange() []string
+}
@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xitonix/flags/core"
+)
+
+func TestValidRangeProvider(t *testing.T) {
+	testCases := []struct {
+		title    string
+		flag     core.Flag
+		expected []string
+	}{
+		{
+			title:    "string flag without valid range",
+			flag:     core.NewString("long", "usage"),
+			expected: nil,
+		},
+		{
+			title:    "string flag with valid range",
+			flag:     core.NewString("long", "usage").WithValidRange(true, "a", "B", "b"),
+			expected: []string{"a", "B"},
+		},
+		{
+			title:    "int flag with valid range",
+			flag:     core.NewInt("long", "usage").WithValidRange(1, 2, 2),
+			expected: []string{"1", "2"},
+		},
+		{
+			title:    "uint slice flag with valid range",
+			flag:     core.NewUIntSlice("long", "usage").WithValidRange(10, 20),
+			expected: []string{"10", "20"},
+		},
+		{
+			title:    "duration flag with valid range",
+			flag:     core.NewDuration("long", "usage").WithValidRange(time.Second),
+			expected: []string{"1s"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			p, ok := tc.flag.(core.ValidRangeProvider)
+			if !ok {
+				t.Fatalf("Expected %T to implement core.ValidRangeProvider", tc.flag)
+			}
+			actual := p.ValidRange()
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected Valid Range: %v, Actual: %v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import "github.com/xitonix/flags/internal"
+
+// Validator is the interface for the reusable validators which can be shared between different flag types.
+//
+// The validate package provides composable implementations of this interface (i.e. validate.All(...)).
+// The validators of the slice flags will be applied to each item of the list.
+type Validator interface {
+	// Validate returns an error if the value is not acceptable.
+	//
+	// The value will be passed using the underlying type of the flag (i.e. int for an IntFlag).
+	Validate(value interface{}) error
+	// Describe returns the human-readable description of the validator, which will be rendered in the help output.
+	Describe() Constraint
+}
+
+// validationErr creates a new error for the values which have been rejected by a validator.
+func validationErr(value interface{}, longName, shortName string, err error) error {
+	return internal.NewMessageErr(internal.MsgValidationFailed, value, internal.GetPrintName(longName, shortName), err)
+}
+
+// applyValidators validates the value using all the validators and returns the first error.
+func applyValidators(value interface{}, validators []Validator, longName, shortName string) error {
+	for _, v := range validators {
+		if err := v.Validate(value); err != nil {
+			return validationErr(value, longName, shortName, err)
+		}
+	}
+	return nil
+}
+
+// describeValidators returns the descriptions of the validators.
+func describeValidators(validators []Validator) []Constraint {
+	constraints := make([]Constraint, 0)
+	for _, v := range validators {
+		constraints = append(constraints, v.Describe())
+	}
+	return constraints
+}
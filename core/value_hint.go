@@ -0,0 +1,20 @@
+package core
+
+// ValueHint describes the kind of value a flag expects.
+//
+// The hints are used by the shell completion generators to offer better suggestions.
+type ValueHint int8
+
+const (
+	// NoHint the flag value is free form.
+	NoHint ValueHint = iota
+	// FileHint the flag value is a path to a file.
+	FileHint
+	// DirectoryHint the flag value is a path to a directory.
+	DirectoryHint
+)
+
+// ValueHintProvider is the interface for the flags which can provide a hint about the kind of value they expect.
+type ValueHintProvider interface {
+	ValueHint() ValueHint
+}
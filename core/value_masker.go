@@ -0,0 +1,32 @@
+package core
+
+// ValueMasker is the interface for the flags whose values may contain sensitive information (i.e. access tokens).
+//
+// The masked values will be rendered in the help output instead of the original values.
+type ValueMasker interface {
+	// MaskValue returns the value with the sensitive parts masked.
+	MaskValue(value string) string
+}
+
+// MaskValueOf returns the value with the sensitive parts masked.
+//
+// The method returns the value as is if the flag does not implement ValueMasker interface.
+func MaskValueOf(f Flag, value string) string {
+	if m, ok := f.(ValueMasker); ok {
+		return m.MaskValue(value)
+	}
+	return value
+}
+
+// MaskProvenanceOf returns a copy of the provenance with the sensitive parts of its value masked.
+//
+// The method returns the provenance as is if the flag does not implement ValueMasker interface.
+func MaskProvenanceOf(f Flag, p *Provenance) *Provenance {
+	m, ok := f.(ValueMasker)
+	if !ok || p == nil {
+		return p
+	}
+	masked := *p
+	masked.Value = m.MaskValue(p.Value)
+	return &masked
+}
@@ -0,0 +1,283 @@
+package flags
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/internal"
+)
+
+// flagDoc holds the documentation details of a flag.
+type flagDoc struct {
+	long, short  string
+	flagType     string
+	key          string
+	usage        string
+	defaultValue string
+	hasDefault   bool
+	validValues  []string
+	valueRange   string
+	constraints  []core.Constraint
+	isRequired   bool
+	isDeprecated bool
+	deprecation  *core.Deprecation
+}
+
+// ManPage writes the documentation of the registered flags to w in roff (man(7)) format.
+//
+// The output includes the program name, description, synopsis and the details of each visible flag, such as its
+// names, type, key, default value, acceptable values and status. The flags will be documented in the same order
+// as the help output (See config.WithSortOrder). The output does not depend on the time or the environment in which
+// it has been generated, which makes it suitable to be compared in CI pipelines.
+func (b *Bucket) ManPage(w io.Writer) error {
+	prog := b.opts.ProgramName
+	messages := b.messages()
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, ".TH %s 1 \"\" %s %s\n", roffQuote(strings.ToUpper(prog)), roffQuote(b.versionString()), roffQuote(messages.Message(core.MsgManualTitle)))
+
+	sb.WriteString(roffSection(messages.Message(core.MsgNameSection)))
+	name := roffEscape(prog)
+	if summary := firstLine(b.opts.Description); !internal.IsEmpty(summary) {
+		name += ` \- ` + roffEscape(summary)
+	}
+	sb.WriteString(name + "\n")
+
+	sb.WriteString(roffSection(messages.Message(core.MsgSynopsisSection)))
+	sb.WriteString(roffEscape(b.synopsis()) + "\n")
+
+	if !internal.IsEmpty(b.opts.Description) {
+		sb.WriteString(roffSection(messages.Message(core.MsgDescriptionSection)))
+		sb.WriteString(roffParagraphs(b.opts.Description))
+	}
+
+	docs := b.flagDocs()
+	if len(docs) > 0 {
+		sb.WriteString(roffSection(messages.Message(core.MsgOptionsSection)))
+		for _, doc := range docs {
+			sb.WriteString(".TP\n")
+			names := `\fB\-\-` + roffEscape(doc.long) + `\fR`
+			if !internal.IsEmpty(doc.short) {
+				names = `\fB\-` + roffEscape(doc.short) + `\fR, ` + names
+			}
+			fmt.Fprintf(sb, "%s \\fI%s\\fR\n", names, roffEscape(doc.flagType))
+			if !internal.IsEmpty(doc.usage) {
+				sb.WriteString(roffEscape(doc.usage) + "\n")
+			}
+			details := make([]string, 0)
+			if !internal.IsEmpty(doc.key) {
+				details = append(details, messages.Message(core.MsgEnvironmentLabel)+" "+doc.key)
+			}
+			if doc.hasDefault {
+				details = append(details, messages.Message(core.MsgDefaultLabel)+" "+doc.defaultValue)
+			}
+			for _, detail := range append(details, doc.notes(messages)...) {
+				sb.WriteString(".br\n")
+				sb.WriteString(roffEscape(detail) + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Markdown writes the documentation of the registered flags to w in Markdown format.
+//
+// The output includes the program name, description, synopsis and the details of each visible flag, such as its
+// names, type, key, default value, acceptable values and status. The flags will be documented in the same order
+// as the help output (See config.WithSortOrder). The output does not depend on the time or the environment in which
+// it has been generated, which makes it suitable to be compared in CI pipelines.
+func (b *Bucket) Markdown(w io.Writer) error {
+	messages := b.messages()
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "# %s\n\n", b.opts.ProgramName)
+
+	if !internal.IsEmpty(b.opts.Description) {
+		sb.WriteString(strings.TrimSpace(b.opts.Description) + "\n\n")
+	}
+
+	fmt.Fprintf(sb, "## %s\n\n", messages.Message(core.MsgSynopsisSection))
+	fmt.Fprintf(sb, "```\n%s\n```\n", b.synopsis())
+
+	docs := b.flagDocs()
+	if len(docs) > 0 {
+		fmt.Fprintf(sb, "\n## %s\n\n", messages.Message(core.MsgFlagsSection))
+		sb.WriteString(markdownTableHeader(
+			messages.Message(core.MsgFlagColumn),
+			messages.Message(core.MsgTypeColumn),
+			messages.Message(core.MsgEnvironmentColumn),
+			messages.Message(core.MsgDefaultColumn),
+			messages.Message(core.MsgDescriptionColumn)))
+		for _, doc := range docs {
+			names := "`--" + doc.long + "`"
+			if !internal.IsEmpty(doc.short) {
+				names = "`-" + doc.short + "`, " + names
+			}
+			var key, def string
+			if !internal.IsEmpty(doc.key) {
+				key = "`" + doc.key + "`"
+			}
+			if doc.hasDefault {
+				def = "`" + doc.defaultValue + "`"
+			}
+			description := doc.usage
+			for _, note := range doc.notes(messages) {
+				if !internal.IsEmpty(description) {
+					description += "<br>"
+				}
+				description += note
+			}
+			fmt.Fprintf(sb, "| %s | %s | %s | %s | %s |\n",
+				markdownEscape(names),
+				markdownEscape(doc.flagType),
+				markdownEscape(key),
+				markdownEscape(def),
+				markdownEscape(description))
+		}
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// flagDocs returns the documentation details of all the visible flags in the bucket's sort order.
+func (b *Bucket) flagDocs() []*flagDoc {
+	b.initKeys()
+	docs := make([]*flagDoc, 0)
+	for _, f := range b.sortFlags() {
+		if f.IsHidden() {
+			continue
+		}
+		doc := &flagDoc{
+			long:         f.LongName(),
+			short:        f.ShortName(),
+			flagType:     f.Type(),
+			key:          f.Key().String(),
+			usage:        f.Usage(),
+			isRequired:   f.IsRequired(),
+			isDeprecated: f.IsDeprecated(),
+		}
+		if dv := f.Default(); dv != nil {
+			doc.hasDefault = true
+			doc.defaultValue = fmt.Sprintf("%v", dv)
+		}
+		if p, ok := f.(core.ValidRangeProvider); ok {
+			doc.validValues = p.ValidRange()
+		}
+		doc.valueRange = core.RangeOf(f).String()
+		doc.constraints = core.ConstraintsOf(f)
+		if p, ok := f.(core.DeprecationProvider); ok {
+			doc.deprecation = p.Deprecation()
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// notes returns the flag's acceptable values and status notes, translated using the message catalogue.
+func (d *flagDoc) notes(messages core.MessageCatalogue) []string {
+	notes := make([]string, 0)
+	if len(d.validValues) > 0 {
+		notes = append(notes, messages.Message(core.MsgValidValuesLabel)+" "+strings.Join(d.validValues, ", "))
+	}
+	if !internal.IsEmpty(d.valueRange) {
+		notes = append(notes, messages.Message(core.MsgRangeLabel)+" "+d.valueRange)
+	}
+	if len(d.constraints) > 0 {
+		constraints := make([]string, len(d.constraints))
+		for i, c := range d.constraints {
+			constraints[i] = c.Format(messages)
+		}
+		notes = append(notes, messages.Message(core.MsgConstraintsLabel)+" "+strings.Join(constraints, ", "))
+	}
+	if d.isRequired {
+		notes = append(notes, messages.Message(core.MsgRequiredNote))
+	}
+	if d.isDeprecated {
+		deprecated := messages.Message(core.MsgDeprecatedNote)
+		if d.deprecation != nil {
+			if !internal.IsEmpty(d.deprecation.Message) {
+				deprecated += " " + d.deprecation.Message
+			}
+			if !internal.IsEmpty(d.deprecation.Replacement) {
+				deprecated += " " + messages.Message(core.MsgUseReplacement, d.deprecation.Replacement)
+			}
+		}
+		notes = append(notes, deprecated)
+	}
+	return notes
+}
+
+// synopsis returns the usage line of the running tool.
+func (b *Bucket) synopsis() string {
+	if !internal.IsEmpty(b.opts.Synopsis) {
+		return b.opts.Synopsis
+	}
+	return b.opts.ProgramName + " [flags]"
+}
+
+func (b *Bucket) versionString() string {
+	if b.version == nil || internal.IsEmpty(b.version.Version) {
+		return b.opts.ProgramName
+	}
+	return b.opts.ProgramName + " " + b.version.Version
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n"); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return s
+}
+
+// roffEscape escapes the roff special characters of a single line of text.
+func roffEscape(s string) string {
+	s = strings.Replace(s, `\`, `\e`, -1)
+	s = strings.Replace(s, "-", `\-`, -1)
+	if strings.HasPrefix(s, ".") || strings.HasPrefix(s, "'") {
+		s = `\&` + s
+	}
+	return s
+}
+
+func roffQuote(s string) string {
+	return `"` + strings.Replace(roffEscape(s), `"`, `\(dq`, -1) + `"`
+}
+
+// roffParagraphs converts the blank line separated paragraphs of the text into roff paragraphs.
+func roffParagraphs(text string) string {
+	sb := &strings.Builder{}
+	for i, paragraph := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if i > 0 {
+			sb.WriteString(".PP\n")
+		}
+		for _, line := range strings.Split(strings.TrimSpace(paragraph), "\n") {
+			sb.WriteString(roffEscape(strings.TrimSpace(line)) + "\n")
+		}
+	}
+	return sb.String()
+}
+
+// roffSection returns the heading of a man page section in upper case (i.e. .SH OPTIONS).
+func roffSection(title string) string {
+	return ".SH " + roffEscape(strings.ToUpper(title)) + "\n"
+}
+
+// markdownTableHeader returns the header row of a Markdown table, followed by the delimiter row.
+func markdownTableHeader(columns ...string) string {
+	header, delimiter := "|", "|"
+	for _, column := range columns {
+		column = markdownEscape(column)
+		header += " " + column + " |"
+		delimiter += strings.Repeat("-", utf8.RuneCountInString(column)+2) + "|"
+	}
+	return header + "\n" + delimiter + "\n"
+}
+
+func markdownEscape(s string) string {
+	return strings.Replace(s, "|", `\|`, -1)
+}
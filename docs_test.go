@@ -0,0 +1,259 @@
+package flags
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xitonix/flags/by"
+	"github.com/xitonix/flags/config"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func newDocsTestBucket(opts ...config.Option) *Bucket {
+	opts = append([]config.Option{
+		config.WithProgramName("my-tool"),
+		config.WithAutoKeys(),
+		config.WithKeyPrefix("app"),
+	}, opts...)
+	bucket := newBucket(nil, mocks.NewEnvReader(), opts...)
+	bucket.Int("port", "Port number").WithShort("p").WithDefault(80).WithValidRange(80, 443)
+	bucket.String("name", "Service | name").Required()
+	bucket.String("hidden", "Hidden").Hide()
+	bucket.Bool("old", "Legacy flag").WithKey("-").MarkAsDeprecated(core.WithDeprecationMessage("Not needed."), core.WithReplacement("name"))
+	return bucket
+}
+
+func TestBucket_ManPage(t *testing.T) {
+	testCases := []struct {
+		title    string
+		opts     []config.Option
+		version  string
+		expected string
+	}{
+		{
+			title: "without description",
+			expected: `.TH "MY\-TOOL" 1 "" "my\-tool" "User Commands"
+.SH NAME
+my\-tool
+.SH SYNOPSIS
+my\-tool [flags]
+.SH OPTIONS
+.TP
+\fB\-p\fR, \fB\-\-port\fR \fIint\fR
+Port number
+.br
+Environment: APP_PORT
+.br
+Default: 80
+.br
+Valid values: 80, 443
+.TP
+\fB\-\-name\fR \fIstring\fR
+Service | name
+.br
+Environment: APP_NAME
+.br
+Required.
+.TP
+\fB\-\-old\fR \fIbool\fR
+Legacy flag
+.br
+Deprecated. Not needed. Use \-\-name instead.
+`,
+		},
+		{
+			title: "with description, synopsis, version and sort order",
+			opts: []config.Option{
+				config.WithDescription("Does things.\nVery well.\n\n.Second paragraph."),
+				config.WithSynopsis("my-tool [flags] <file>"),
+				config.WithSortOrder(by.RequiredFirst),
+			},
+			version: "1.0.0",
+			expected: `.TH "MY\-TOOL" 1 "" "my\-tool 1.0.0" "User Commands"
+.SH NAME
+my\-tool \- Does things.
+.SH SYNOPSIS
+my\-tool [flags] <file>
+.SH DESCRIPTION
+Does things.
+Very well.
+.PP
+\&.Second paragraph.
+.SH OPTIONS
+.TP
+\fB\-\-name\fR \fIstring\fR
+Service | name
+.br
+Environment: APP_NAME
+.br
+Required.
+.TP
+\fB\-p\fR, \fB\-\-port\fR \fIint\fR
+Port number
+.br
+Environment: APP_PORT
+.br
+Default: 80
+.br
+Valid values: 80, 443
+.TP
+\fB\-\-old\fR \fIbool\fR
+Legacy flag
+.br
+Deprecated. Not needed. Use \-\-name instead.
+`,
+		},
+		{
+			title: "with message catalogue",
+			opts: []config.Option{config.WithMessageCatalogue(core.MessageMap{
+				core.MsgManualTitle:      "Benutzerbefehle",
+				core.MsgNameSection:      "Name",
+				core.MsgSynopsisSection:  "Übersicht",
+				core.MsgOptionsSection:   "Optionen",
+				core.MsgEnvironmentLabel: "Umgebung:",
+				core.MsgDefaultLabel:     "Standard:",
+				core.MsgValidValuesLabel: "Gültige Werte:",
+				core.MsgRequiredNote:     "Erforderlich.",
+				core.MsgDeprecatedNote:   "Veraltet.",
+				core.MsgUseReplacement:   "Verwenden Sie stattdessen --%s.",
+			})},
+			expected: `.TH "MY\-TOOL" 1 "" "my\-tool" "Benutzerbefehle"
+.SH NAME
+my\-tool
+.SH ÜBERSICHT
+my\-tool [flags]
+.SH OPTIONEN
+.TP
+\fB\-p\fR, \fB\-\-port\fR \fIint\fR
+Port number
+.br
+Umgebung: APP_PORT
+.br
+Standard: 80
+.br
+Gültige Werte: 80, 443
+.TP
+\fB\-\-name\fR \fIstring\fR
+Service | name
+.br
+Umgebung: APP_NAME
+.br
+Erforderlich.
+.TP
+\fB\-\-old\fR \fIbool\fR
+Legacy flag
+.br
+Veraltet. Not needed. Verwenden Sie stattdessen \-\-name.
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			bucket := newDocsTestBucket(tc.opts...)
+			if tc.version != "" {
+				bucket.Version(tc.version)
+			}
+			buf := &bytes.Buffer{}
+			if err := bucket.ManPage(buf); err != nil {
+				t.Fatalf("Expected no error, but received %v", err)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("Expected:\n%s\nActual:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestBucket_Markdown(t *testing.T) {
+	testCases := []struct {
+		title    string
+		opts     []config.Option
+		flags    bool
+		expected string
+	}{
+		{
+			title: "without flags",
+			expected: "# my-tool\n\n" +
+				"## Synopsis\n\n" +
+				"```\nmy-tool [flags]\n```\n",
+		},
+		{
+			title: "with flags and description",
+			opts:  []config.Option{config.WithDescription("Does things.")},
+			flags: true,
+			expected: "# my-tool\n\n" +
+				"Does things.\n\n" +
+				"## Synopsis\n\n" +
+				"```\nmy-tool [flags]\n```\n\n" +
+				"## Flags\n\n" +
+				"| Flag | Type | Environment Variable | Default | Description |\n" +
+				"|------|------|----------------------|---------|-------------|\n" +
+				"| `-p`, `--port` | int | `APP_PORT` | `80` | Port number<br>Valid values: 80, 443 |\n" +
+				"| `--name` | string | `APP_NAME` |  | Service \\| name<br>Required. |\n" +
+				"| `--old` | bool |  |  | Legacy flag<br>Deprecated. Not needed. Use --name instead. |\n",
+		},
+		{
+			title: "with message catalogue",
+			opts: []config.Option{config.WithMessageCatalogue(core.MessageMap{
+				core.MsgValidValuesLabel:  "Gültige Werte:",
+				core.MsgRequiredNote:      "Erforderlich.",
+				core.MsgDeprecatedNote:    "Veraltet.",
+				core.MsgUseReplacement:    "Verwenden Sie stattdessen --%s.",
+				core.MsgSynopsisSection:   "Übersicht",
+				core.MsgFlagsSection:      "Optionen",
+				core.MsgFlagColumn:        "Option",
+				core.MsgTypeColumn:        "Typ",
+				core.MsgEnvironmentColumn: "Umgebungsvariable",
+				core.MsgDefaultColumn:     "Standard",
+				core.MsgDescriptionColumn: "Beschreibung",
+			})},
+			flags: true,
+			expected: "# my-tool\n\n" +
+				"## Übersicht\n\n" +
+				"```\nmy-tool [flags]\n```\n\n" +
+				"## Optionen\n\n" +
+				"| Option | Typ | Umgebungsvariable | Standard | Beschreibung |\n" +
+				"|--------|-----|-------------------|----------|--------------|\n" +
+				"| `-p`, `--port` | int | `APP_PORT` | `80` | Port number<br>Gültige Werte: 80, 443 |\n" +
+				"| `--name` | string | `APP_NAME` |  | Service \\| name<br>Erforderlich. |\n" +
+				"| `--old` | bool |  |  | Legacy flag<br>Veraltet. Not needed. Verwenden Sie stattdessen --name. |\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			var bucket *Bucket
+			if tc.flags {
+				bucket = newDocsTestBucket(tc.opts...)
+			} else {
+				bucket = newBucket(nil, mocks.NewEnvReader(), append(tc.opts, config.WithProgramName("my-tool"))...)
+			}
+			buf := &bytes.Buffer{}
+			if err := bucket.Markdown(buf); err != nil {
+				t.Fatalf("Expected no error, but received %v", err)
+			}
+			if buf.String() != tc.expected {
+				t.Errorf("Expected:\n%s\nActual:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestBucket_Docs_Before_Parse(t *testing.T) {
+	bucket := newDocsTestBucket()
+	if err := bucket.Markdown(&bytes.Buffer{}); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	lg := &mocks.Logger{}
+	tm := &mocks.Terminator{}
+	bucket.opts.Logger = lg
+	bucket.opts.Terminator = tm
+	bucket.argSource, _ = newArgSource([]string{"--name", "value"})
+	bucket.sources[0] = bucket.argSource
+	bucket.Parse()
+	if tm.IsTerminated {
+		t.Errorf("Did not expect to terminate, but it happened with %v", lg.Error)
+	}
+}
@@ -0,0 +1,357 @@
+package flags
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/internal"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect of the exported schemas.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type catalogue struct {
+	Program     string           `json:"program"`
+	Version     string           `json:"version,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Flags       []*catalogueFlag `json:"flags"`
+}
+
+type catalogueFlag struct {
+	LongName    string                `json:"long_name"`
+	ShortName   string                `json:"short_name,omitempty"`
+	Key         string                `json:"key,omitempty"`
+	Type        string                `json:"type"`
+	Description string                `json:"description,omitempty"`
+	Default     interface{}           `json:"default,omitempty"`
+	ValidValues []string              `json:"valid_values,omitempty"`
+	Range       string                `json:"range,omitempty"`
+	Constraints []string              `json:"constraints,omitempty"`
+	Group       string                `json:"group,omitempty"`
+	Required    bool                  `json:"required"`
+	Deprecated  bool                  `json:"deprecated"`
+	Hidden      bool                  `json:"hidden"`
+	Deprecation *catalogueDeprecation `json:"deprecation,omitempty"`
+}
+
+type catalogueDeprecation struct {
+	Message     string `json:"message,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
+	Enum                 []interface{}          `json:"enum,omitempty"`
+	Minimum              interface{}            `json:"minimum,omitempty"`
+	Maximum              interface{}            `json:"maximum,omitempty"`
+	ExclusiveMinimum     interface{}            `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     interface{}            `json:"exclusiveMaximum,omitempty"`
+	MinLength            interface{}            `json:"minLength,omitempty"`
+	MaxLength            interface{}            `json:"maxLength,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	MinItems             interface{}            `json:"minItems,omitempty"`
+	MaxItems             interface{}            `json:"maxItems,omitempty"`
+	UniqueItems          bool                   `json:"uniqueItems,omitempty"`
+	Deprecated           bool                   `json:"deprecated,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+}
+
+// integerBounds holds the minimum and maximum values of the built-in integer flag types.
+var integerBounds = map[string][2]interface{}{
+	"int8":   {math.MinInt8, math.MaxInt8},
+	"int16":  {math.MinInt16, math.MaxInt16},
+	"int32":  {math.MinInt32, math.MaxInt32},
+	"int64":  {int64(math.MinInt64), int64(math.MaxInt64)},
+	"int":    {int64(math.MinInt64), int64(math.MaxInt64)},
+	"uint8":  {0, math.MaxUint8},
+	"byte":   {0, math.MaxUint8},
+	"uint16": {0, math.MaxUint16},
+	"uint32": {0, uint64(math.MaxUint32)},
+	"uint64": {0, uint64(math.MaxUint64)},
+	"uint":   {0, uint64(math.MaxUint64)},
+}
+
+// JSONCatalogue writes a machine-readable catalogue of all the registered flags to w in JSON format.
+//
+// The catalogue includes the program name, version and description, followed by the names, keys, types, defaults,
+// valid values, groups and the required, deprecated and hidden status of each flag (including the hidden flags).
+// The flags will be listed in the same order as the help output (See config.WithSortOrder).
+func (b *Bucket) JSONCatalogue(w io.Writer) error {
+	b.initKeys()
+	c := &catalogue{
+		Program:     b.opts.ProgramName,
+		Description: strings.TrimSpace(b.opts.Description),
+		Flags:       make([]*catalogueFlag, 0),
+	}
+	if b.version != nil {
+		c.Version = b.version.Version
+	}
+
+	for _, f := range b.sortFlags() {
+		cf := &catalogueFlag{
+			LongName:    f.LongName(),
+			ShortName:   f.ShortName(),
+			Key:         f.Key().String(),
+			Type:        f.Type(),
+			Description: f.Usage(),
+			Group:       core.GroupOf(f),
+			Required:    f.IsRequired(),
+			Deprecated:  f.IsDeprecated(),
+			Hidden:      f.IsHidden(),
+		}
+		if dv := f.Default(); dv != nil {
+			cf.Default = jsonValue(dv, f.Type())
+		}
+		if p, ok := f.(core.ValidRangeProvider); ok {
+			cf.ValidValues = p.ValidRange()
+		}
+		cf.Range = core.RangeOf(f).String()
+		for _, c := range core.ConstraintsOf(f) {
+			cf.Constraints = append(cf.Constraints, c.String())
+		}
+		if p, ok := f.(core.DeprecationProvider); ok && p.Deprecation() != nil {
+			d := p.Deprecation()
+			if !internal.IsEmpty(d.Message) || !internal.IsEmpty(d.Replacement) {
+				cf.Deprecation = &catalogueDeprecation{
+					Message:     d.Message,
+					Replacement: d.Replacement,
+				}
+			}
+		}
+		c.Flags = append(c.Flags, cf)
+	}
+	return writeJSON(w, c)
+}
+
+// JSONSchema writes a JSON Schema (draft 2020-12) document which describes the values of the registered flags to w.
+//
+// The schema describes an object, with a property for each flag, named after the flag's key. That makes the schema
+// suitable to validate the documents which will be loaded by custom sources, since the sources are queried by keys.
+// The flags without a key will not be included, because their values cannot be provided by any custom source.
+//
+// Each property includes the type, description, default value, valid values and the deprecation status of the flag.
+// The keys of the required flags will be listed in the "required" section of the schema.
+func (b *Bucket) JSONSchema(w io.Writer) error {
+	b.initKeys()
+	schema := &jsonSchema{
+		Schema:      JSONSchemaDraft,
+		Title:       b.opts.ProgramName,
+		Description: strings.TrimSpace(b.opts.Description),
+		Type:        "object",
+		Properties:  make(map[string]*jsonSchema),
+	}
+
+	for _, f := range b.sortFlags() {
+		key := f.Key().String()
+		if internal.IsEmpty(key) {
+			continue
+		}
+		property := typeSchema(f.Type())
+		property.Description = f.Usage()
+		property.Deprecated = f.IsDeprecated()
+		if dv := f.Default(); dv != nil {
+			property.Default = jsonValue(dv, f.Type())
+		}
+		if p, ok := f.(core.ValidRangeProvider); ok && len(p.ValidRange()) > 0 {
+			target := property
+			if property.Items != nil {
+				target = property.Items
+			}
+			for _, v := range p.ValidRange() {
+				target.Enum = append(target.Enum, parseJSONValue(v, target.Type))
+			}
+		}
+		if r := core.RangeOf(f); r != nil {
+			target := property
+			if property.Items != nil {
+				target = property.Items
+			}
+			applyRange(target, r)
+		}
+		applyConstraints(property, core.ConstraintsOf(f))
+		schema.Properties[key] = property
+		if f.IsRequired() {
+			schema.Required = append(schema.Required, key)
+		}
+	}
+	return writeJSON(w, schema)
+}
+
+// typeSchema returns the JSON schema of the specified flag type.
+func typeSchema(flagType string) *jsonSchema {
+	if strings.HasPrefix(flagType, "[]") {
+		return &jsonSchema{
+			Type:  "array",
+			Items: typeSchema(strings.TrimPrefix(flagType, "[]")),
+		}
+	}
+	schema := &jsonSchema{Type: jsonType(flagType)}
+	if flagType == "[string]string" {
+		schema.AdditionalProperties = &jsonSchema{Type: "string"}
+	}
+	if bounds, ok := integerBounds[flagType]; ok {
+		schema.Minimum = bounds[0]
+		schema.Maximum = bounds[1]
+	}
+	if flagType == "counter" {
+		schema.Minimum = 0
+	}
+	return schema
+}
+
+// applyRange narrows down the minimum and the maximum values of the numeric schema to the bounds of the range.
+func applyRange(schema *jsonSchema, r *core.Range) {
+	if schema.Type != "integer" && schema.Type != "number" {
+		return
+	}
+	if r.Min != "" {
+		if r.MinBound == core.Exclusive {
+			schema.Minimum = nil
+			schema.ExclusiveMinimum = parseJSONValue(r.Min, schema.Type)
+		} else {
+			schema.Minimum = parseJSONValue(r.Min, schema.Type)
+		}
+	}
+	if r.Max != "" {
+		if r.MaxBound == core.Exclusive {
+			schema.Maximum = nil
+			schema.ExclusiveMaximum = parseJSONValue(r.Max, schema.Type)
+		} else {
+			schema.Maximum = parseJSONValue(r.Max, schema.Type)
+		}
+	}
+}
+
+// applyConstraints translates the constraints of the flag into the keywords of the JSON schema.
+//
+// The constraints on the items of the lists (i.e. pattern) will be applied to the schema of the items.
+func applyConstraints(schema *jsonSchema, constraints []core.Constraint) {
+	target := schema
+	if schema.Items != nil {
+		target = schema.Items
+	}
+	for _, c := range constraints {
+		switch c.Message {
+		case core.MsgNonEmpty:
+			target.MinLength = 1
+		case core.MsgLength:
+			if r, ok := c.Args[0].(*core.Range); ok {
+				target.MinLength = boundValue(r.Min, target.MinLength)
+				target.MaxLength = boundValue(r.Max, target.MaxLength)
+			}
+		case core.MsgPattern:
+			target.Pattern = fmt.Sprint(c.Args[0])
+		case core.MsgFormat:
+			switch c.Args[0] {
+			case core.HostnameFormat:
+				target.Format = "hostname"
+			case core.EmailFormat:
+				target.Format = "email"
+			case core.UUIDFormat:
+				target.Format = "uuid"
+			case core.IdentifierFormat:
+				target.Pattern = "^[a-zA-Z_][a-zA-Z0-9_]*$"
+			}
+		case core.MsgItems:
+			if r, ok := c.Args[0].(*core.Range); ok {
+				schema.MinItems = boundValue(r.Min, schema.MinItems)
+				schema.MaxItems = boundValue(r.Max, schema.MaxItems)
+			}
+		case core.MsgUnique:
+			schema.UniqueItems = true
+		}
+	}
+}
+
+// boundValue returns the integer value of the bound, or the current value if the bound has not been defined.
+func boundValue(bound string, current interface{}) interface{} {
+	if bound == "" {
+		return current
+	}
+	return parseJSONValue(bound, "integer")
+}
+
+// jsonType returns the JSON type of the specified flag type.
+func jsonType(flagType string) string {
+	switch flagType {
+	case "bool":
+		return "boolean"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "byte", "counter":
+		return "integer"
+	case "float32", "float64":
+		return "number"
+	case "[string]string":
+		return "object"
+	default:
+		if strings.HasPrefix(flagType, "[]") {
+			return "array"
+		}
+		return "string"
+	}
+}
+
+// jsonValue converts the value of the specified flag type to a JSON compatible value.
+//
+// The values of the types which are represented as strings in JSON (i.e. durations or IP addresses) will be
+// converted to their string representation.
+func jsonValue(value interface{}, flagType string) interface{} {
+	switch jsonType(flagType) {
+	case "string":
+		return fmt.Sprintf("%v", value)
+	case "array":
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice {
+			return value
+		}
+		items := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items[i] = jsonValue(v.Index(i).Interface(), strings.TrimPrefix(flagType, "[]"))
+		}
+		return items
+	default:
+		return value
+	}
+}
+
+// parseJSONValue parses the string representation of a value into the specified JSON type.
+func parseJSONValue(value, jsonType string) interface{} {
+	switch jsonType {
+	case "integer":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+		if v, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return v
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		if v, err := strconv.ParseBool(value); err == nil {
+			return v
+		}
+	}
+	return value
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
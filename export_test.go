@@ -0,0 +1,298 @@
+package flags
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/xitonix/flags/config"
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/mocks"
+)
+
+func newExportTestBucket() *Bucket {
+	bucket := newBucket(nil, mocks.NewEnvReader(),
+		config.WithProgramName("my-tool"),
+		config.WithDescription("Does things."),
+		config.WithAutoKeys())
+	bucket.Version("1.0.0")
+	bucket.Int8("level", "Level").WithShort("l").WithDefault(2).WithValidRange(1, 2, 3).WithGroup("Logging")
+	bucket.Duration("timeout", "Timeout").WithDefault(time.Second).Required()
+	bucket.StringSlice("tags", "Tags").WithDefault([]string{"a", "b"}).WithValidRange(true, "a", "b", "c")
+	bucket.Bool("old", "Old").WithKey("-").MarkAsDeprecated(core.WithReplacement("timeout"))
+	bucket.StringMap("labels", "Labels").Hide().MarkAsDeprecated()
+	return bucket
+}
+
+func TestBucket_JSONCatalogue(t *testing.T) {
+	expected := `{
+  "program": "my-tool",
+  "version": "1.0.0",
+  "description": "Does things.",
+  "flags": [
+    {
+      "long_name": "level",
+      "short_name": "l",
+      "key": "LEVEL",
+      "type": "int8",
+      "description": "Level",
+      "default": 2,
+      "valid_values": [
+        "1",
+        "2",
+        "3"
+      ],
+      "group": "Logging",
+      "required": false,
+      "deprecated": false,
+      "hidden": false
+    },
+    {
+      "long_name": "timeout",
+      "key": "TIMEOUT",
+      "type": "duration",
+      "description": "Timeout",
+      "default": "1s",
+      "required": true,
+      "deprecated": false,
+      "hidden": false
+    },
+    {
+      "long_name": "tags",
+      "key": "TAGS",
+      "type": "[]string",
+      "description": "Tags",
+      "default": [
+        "a",
+        "b"
+      ],
+      "valid_values": [
+        "a",
+        "b",
+        "c"
+      ],
+      "required": false,
+      "deprecated": false,
+      "hidden": false
+    },
+    {
+      "long_name": "old",
+      "type": "bool",
+      "description": "Old",
+      "required": false,
+      "deprecated": true,
+      "hidden": false,
+      "deprecation": {
+        "replacement": "timeout"
+      }
+    },
+    {
+      "long_name": "labels",
+      "key": "LABELS",
+      "type": "[string]string",
+      "description": "Labels",
+      "required": false,
+      "deprecated": true,
+      "hidden": true
+    }
+  ]
+}
+`
+	buf := &bytes.Buffer{}
+	if err := newExportTestBucket().JSONCatalogue(buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nActual:\n%s", expected, buf.String())
+	}
+}
+
+func TestBucket_JSONSchema(t *testing.T) {
+	expected := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "my-tool",
+  "description": "Does things.",
+  "type": "object",
+  "properties": {
+    "LABELS": {
+      "description": "Labels",
+      "type": "object",
+      "deprecated": true,
+      "additionalProperties": {
+        "type": "string"
+      }
+    },
+    "LEVEL": {
+      "description": "Level",
+      "type": "integer",
+      "default": 2,
+      "enum": [
+        1,
+        2,
+        3
+      ],
+      "minimum": -128,
+      "maximum": 127
+    },
+    "TAGS": {
+      "description": "Tags",
+      "type": "array",
+      "default": [
+        "a",
+        "b"
+      ],
+      "items": {
+        "type": "string",
+        "enum": [
+          "a",
+          "b",
+          "c"
+        ]
+      }
+    },
+    "TIMEOUT": {
+      "description": "Timeout",
+      "type": "string",
+      "default": "1s"
+    }
+  },
+  "required": [
+    "TIMEOUT"
+  ]
+}
+`
+	buf := &bytes.Buffer{}
+	if err := newExportTestBucket().JSONSchema(buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nActual:\n%s", expected, buf.String())
+	}
+}
+
+func TestBucket_JSONSchema_Range(t *testing.T) {
+	expected := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "my-tool",
+  "type": "object",
+  "properties": {
+    "PORTS": {
+      "description": "Ports",
+      "type": "array",
+      "items": {
+        "type": "integer",
+        "minimum": 1,
+        "maximum": 65535
+      }
+    },
+    "RATIO": {
+      "description": "Ratio",
+      "type": "number",
+      "exclusiveMinimum": 0,
+      "exclusiveMaximum": 1
+    },
+    "TIMEOUT": {
+      "description": "Timeout",
+      "type": "string"
+    }
+  }
+}
+`
+	bucket := newBucket(nil, mocks.NewEnvReader(), config.WithProgramName("my-tool"), config.WithAutoKeys())
+	bucket.IntSlice("ports", "Ports").WithBetween(1, 65535)
+	bucket.Float64("ratio", "Ratio").WithBetween(0, 1, core.Exclusive)
+	bucket.Duration("timeout", "Timeout").WithMin(time.Second)
+	buf := &bytes.Buffer{}
+	if err := bucket.JSONSchema(buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nActual:\n%s", expected, buf.String())
+	}
+}
+
+func TestBucket_JSONSchema_Constraints(t *testing.T) {
+	expected := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "my-tool",
+  "type": "object",
+  "properties": {
+    "EMAILS": {
+      "description": "Emails",
+      "type": "array",
+      "minItems": 1,
+      "uniqueItems": true,
+      "items": {
+        "type": "string",
+        "format": "email"
+      }
+    },
+    "NAME": {
+      "description": "Name",
+      "type": "string",
+      "minLength": 1,
+      "maxLength": 10,
+      "pattern": "^[a-z]+$"
+    }
+  }
+}
+`
+	bucket := newBucket(nil, mocks.NewEnvReader(), config.WithProgramName("my-tool"), config.WithAutoKeys())
+	bucket.StringSlice("emails", "Emails").WithFormat(core.EmailFormat).WithMinItems(1).Unique()
+	bucket.String("name", "Name").NonEmpty().WithMaxLength(10).WithPattern(regexp.MustCompile("^[a-z]+$"))
+	buf := &bytes.Buffer{}
+	if err := bucket.JSONSchema(buf); err != nil {
+		t.Fatalf("Expected no error, but received %v", err)
+	}
+	if buf.String() != expected {
+		t.Errorf("Expected:\n%s\nActual:\n%s", expected, buf.String())
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	testCases := []struct {
+		title    string
+		value    interface{}
+		flagType string
+		expected string
+	}{
+		{
+			title:    "integer",
+			value:    10,
+			flagType: "int",
+			expected: "10",
+		},
+		{
+			title:    "duration slice",
+			value:    []time.Duration{time.Second, time.Minute},
+			flagType: "[]duration",
+			expected: `["1s","1m0s"]`,
+		},
+		{
+			title:    "boolean slice",
+			value:    []bool{true, false},
+			flagType: "[]bool",
+			expected: "[true,false]",
+		},
+		{
+			title:    "string map",
+			value:    map[string]string{"k": "v"},
+			flagType: "[string]string",
+			expected: `{"k":"v"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := writeJSON(buf, jsonValue(tc.value, tc.flagType)); err != nil {
+				t.Fatalf("Expected no error, but received %v", err)
+			}
+			actual := string(bytes.Join(bytes.Fields(buf.Bytes()), nil))
+			if actual != tc.expected {
+				t.Errorf("Expected %s, Actual: %s", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/xitonix/flags/by"
+	"github.com/xitonix/flags/config"
 	"github.com/xitonix/flags/core"
 	"github.com/xitonix/flags/internal"
 )
@@ -38,6 +39,13 @@ func SetLogger(logger core.Logger) {
 	DefaultBucket.opts.Logger = logger
 }
 
+// EnableLogLevelFlag enables the built-in --log-level flag of the default bucket.
+//
+// The flag sets the minimum level of the logger (i.e. --log-level=debug traces the parsing process).
+func EnableLogLevelFlag() {
+	DefaultBucket.EnableLogLevelFlag()
+}
+
 // SetPreSetCallback sets the pre Set callback function for the default bucket.
 //
 // The function will be called before the flag value is being set by a source.
@@ -53,6 +61,15 @@ func SetPostSetCallback(callback core.Callback) {
 	DefaultBucket.opts.PostSetCallback = callback
 }
 
+// AddValidator adds a cross flag validator to the default bucket.
+//
+// The validator will be called once all the flag values have been resolved and the required flags have been checked.
+func AddValidator(validator core.CrossFlagValidator) {
+	if validator != nil {
+		DefaultBucket.opts.Validators = append(DefaultBucket.opts.Validators, validator)
+	}
+}
+
 // SetSortOrder sets the sort order of the default bucket.
 //
 // It decides the order in which the flags will be displayed in the help output.
@@ -64,6 +81,26 @@ func SetSortOrder(comparer by.Comparer) {
 	DefaultBucket.opts.Comparer = comparer
 }
 
+// SetGroupOrder sets the order in which the flag groups of the default bucket will be printed in the help output.
+//
+// The groups which are not listed will be printed after the listed ones, in the same order as they have been declared.
+// The ungrouped flags section will be printed first, unless the default group title has been listed.
+func SetGroupOrder(groups ...string) {
+	DefaultBucket.opts.GroupOrder = groups
+}
+
+// SetGroupSortOrder sets the sort order of the flags within the specified group of the default bucket.
+//
+// The groups without a dedicated comparer will be sorted using the bucket's sort order (See SetSortOrder).
+func SetGroupSortOrder(group string, comparer by.Comparer) {
+	config.WithGroupSortOrder(group, comparer)(DefaultBucket.opts)
+}
+
+// SetDefaultGroupTitle sets the heading of the ungrouped flags section in the help output of the default bucket.
+func SetDefaultGroupTitle(title string) {
+	DefaultBucket.opts.DefaultGroupTitle = title
+}
+
 // SetTerminator sets the internal terminator for the default bucket.
 //
 // The terminator is responsible for terminating the execution of the running tool.
@@ -81,6 +118,34 @@ func SetHelpFormatter(hf core.HelpFormatter) {
 	DefaultBucket.opts.HelpFormatter = hf
 }
 
+// SetKeyFormatString sets the format string of the flag keys in the default bucket's help output (i.e. "[$%s]").
+//
+// The keys will be printed as they are, if the format string is empty.
+func SetKeyFormatString(format string) {
+	DefaultBucket.opts.KeyFormatString = format
+}
+
+// SetEnvPresenceMark sets the mark which will be printed next to the keys which have a value in the environment
+// variables (i.e. "(set)"). The values will never be printed.
+func SetEnvPresenceMark(mark string) {
+	DefaultBucket.opts.EnvPresenceMark = mark
+}
+
+// SetTheme sets the theme of the default bucket's help and error output.
+//
+// Styling will be disabled if the NO_COLOR environment variable is set, or the output is not a terminal.
+// Passing nil disables styling altogether.
+func SetTheme(theme *core.Theme) {
+	DefaultBucket.opts.Theme = theme
+}
+
+// SetMessageCatalogue sets the catalogue which will be used to translate the default bucket's error messages and help output.
+//
+// Passing nil resets the catalogue to English. See core.MessageMap for a map based catalogue.
+func SetMessageCatalogue(catalogue core.MessageCatalogue) {
+	config.WithMessageCatalogue(catalogue)(DefaultBucket.opts)
+}
+
 // SetHelpWriter sets the help writer of the default bucket.
 //
 // The help writer is responsible for printing the formatted help output.
@@ -110,6 +175,108 @@ func SetDefaultValueFormatString(f string) {
 	DefaultBucket.opts.DefaultValueFormatString = f
 }
 
+// SetProgramName sets the name of the running tool for the default bucket.
+//
+// By default the base name of os.Args[0] will be used.
+func SetProgramName(name string) {
+	DefaultBucket.opts.ProgramName = name
+}
+
+// SetSynopsis sets the usage line of the running tool for the default bucket (i.e. "tool [flags] <file>").
+//
+// If not specified, "ProgramName [flags]" will be used.
+func SetSynopsis(synopsis string) {
+	DefaultBucket.opts.Synopsis = synopsis
+}
+
+// SetDescription sets the paragraph which describes what the running tool does for the default bucket.
+func SetDescription(description string) {
+	DefaultBucket.opts.Description = description
+}
+
+// AddExample adds a usage example to the help output of the default bucket.
+//
+// The examples will be printed in the same order as they have been added.
+func AddExample(command, description string) {
+	DefaultBucket.opts.Examples = append(DefaultBucket.opts.Examples, core.Example{
+		Command:     command,
+		Description: description,
+	})
+}
+
+// SetFooter sets the epilogue which will be printed at the end of the help output of the default bucket.
+func SetFooter(footer string) {
+	DefaultBucket.opts.Footer = footer
+}
+
+// SetVersionTemplate sets the text/template which is used to print the version information of the default bucket.
+//
+// The available fields are .Program, .Version, .Commit, .Time, .Dirty and .GoVersion.
+func SetVersionTemplate(template string) {
+	DefaultBucket.opts.VersionTemplate = template
+}
+
+// Version enables the built-in version flag (--version, -V) of the default bucket.
+//
+// Providing the version flag will print the version information using the version template and
+// terminate the execution. Both --version and -V will be reserved once the built-in version flag has been enabled.
+func Version(version string) *VersionInfo {
+	return DefaultBucket.Version(version)
+}
+
+// VersionFromBuildInfo enables the built-in version flag (--version, -V) of the default bucket and populates the
+// version information from the build information embedded in the running binary.
+func VersionFromBuildInfo() *VersionInfo {
+	return DefaultBucket.VersionFromBuildInfo()
+}
+
+// Completion writes the completion script of the specified shell for the default bucket to w.
+//
+// Hidden flags will not be included in the script.
+func Completion(shell Shell, w io.Writer) error {
+	return DefaultBucket.Completion(shell, w)
+}
+
+// RegisterCompletion registers a callback function to provide the dynamic completion candidates for the flag with
+// the specified long name within the default bucket.
+func RegisterCompletion(longName string, callback CompletionFunc) {
+	DefaultBucket.RegisterCompletion(longName, callback)
+}
+
+// ManPage writes the documentation of the default bucket's flags to w in roff (man(7)) format.
+func ManPage(w io.Writer) error {
+	return DefaultBucket.ManPage(w)
+}
+
+// Markdown writes the documentation of the default bucket's flags to w in Markdown format.
+func Markdown(w io.Writer) error {
+	return DefaultBucket.Markdown(w)
+}
+
+// JSONCatalogue writes a machine-readable catalogue of the default bucket's flags to w in JSON format.
+func JSONCatalogue(w io.Writer) error {
+	return DefaultBucket.JSONCatalogue(w)
+}
+
+// JSONSchema writes a JSON Schema (draft 2020-12) document which describes the values of the default bucket's flags to w.
+func JSONSchema(w io.Writer) error {
+	return DefaultBucket.JSONSchema(w)
+}
+
+// Provenance returns the details of where the value of the specified flag of the default bucket came from.
+//
+// The method returns nil if no flag with the specified long name has been registered.
+func Provenance(longName string) *core.Provenance {
+	return DefaultBucket.Provenance(longName)
+}
+
+// Lookup returns the flag of the default bucket with the specified long name.
+//
+// The method returns nil if no flag with the specified long name has been registered.
+func Lookup(longName string) core.Flag {
+	return DefaultBucket.Lookup(longName)
+}
+
 // Parse this is a shortcut for calling the default bucket's Parse method.
 //
 // It parses the flags and queries all the available sources in order, to fill the value of each flag.
@@ -130,6 +297,17 @@ func Add(f core.Flag) {
 	DefaultBucket.Add(f)
 }
 
+// FromFile enables reading the value of the flag with the specified long name from a file or stdin within the default bucket.
+//
+// Once enabled, the content of the file will be passed to the flag if the value starts with core.FilePrefix
+// (i.e. --cert @/path/to/cert.pem), and the content of stdin will be passed if the value is core.StdinValue
+// (i.e. --payload -). It works with any flag type, since the content will be fed into the Set method of the flag.
+//
+// The provenance of the value will record the file path instead of the content.
+func FromFile(longName string, options ...core.FileContentOption) {
+	DefaultBucket.FromFile(longName, options...)
+}
+
 // AppendSource appends a new source to the default bucket.
 //
 // With the default configuration, the order will be:
@@ -327,6 +505,29 @@ func DurationSlice(longName, usage string) *core.DurationSliceFlag {
 	return DefaultBucket.DurationSlice(longName, usage)
 }
 
+// ByteSize adds a new byte size flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. max-size).
+//
+// A byte size string is a non-negative decimal number with an optional fraction and an optional unit suffix,
+// such as "512", "10MB" or "1.5GiB". Valid units are "B", "KB", "MB", "GB", "TB", "PB", "EB" (powers of 1000)
+// and "KiB", "MiB", "GiB", "TiB", "PiB", "EiB" (powers of 1024). The units are case insensitive.
+func ByteSize(longName, usage string) *core.ByteSizeFlag {
+	return DefaultBucket.ByteSize(longName, usage)
+}
+
+// ByteSizeSlice adds a new byte size slice flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. sizes).
+//
+// The value of a byte size slice flag can be set using a comma (or any custom delimiter) separated string of sizes.
+// For example --sizes "512KiB, 10MB, 1.5GiB".
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func ByteSizeSlice(longName, usage string) *core.ByteSizeSliceFlag {
+	return DefaultBucket.ByteSizeSlice(longName, usage)
+}
+
 // Time adds a new Time flag to the default bucket.
 //
 // The long name will be automatically converted to lowercase by the library (i.e. birthday).
@@ -441,6 +642,75 @@ func IPAddressSlice(longName, usage string) *core.IPAddressSliceFlag {
 	return DefaultBucket.IPAddressSlice(longName, usage)
 }
 
+// URL adds a new URL flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. server-url).
+//
+// The value of a URL flag can be specified using any URL string which can be parsed by url.Parse (i.e. "https://example.com:8443/api").
+// The acceptable schemes, the host requirement and the default ports can be defined using
+// WithSchemes(), RequireHost() and WithDefaultPort() methods.
+func URL(longName, usage string) *core.URLFlag {
+	return DefaultBucket.URL(longName, usage)
+}
+
+// URLSlice adds a new URL slice flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. servers).
+//
+// The value of a URL slice flag can be specified using a comma (or any custom delimiter) separated string of URLs
+// (i.e. "https://a.example.com, grpc://b.example.com:50051").
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func URLSlice(longName, usage string) *core.URLSliceFlag {
+	return DefaultBucket.URLSlice(longName, usage)
+}
+
+// FilePath adds a new file path flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. config).
+//
+// The value will be resolved to an absolute path. The leading ~ and the environment variables can be expanded
+// using ExpandHome() and ExpandEnv() methods. The path can be checked against the file system using
+// MustExist(), MustNotExist(), Readable() and Writable() methods.
+func FilePath(longName, usage string) *core.FilePathFlag {
+	return DefaultBucket.FilePath(longName, usage)
+}
+
+// DirPath adds a new directory path flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. output-dir).
+//
+// The value will be resolved to an absolute path. The leading ~ and the environment variables can be expanded
+// using ExpandHome() and ExpandEnv() methods. The path can be checked against the file system using
+// MustExist(), MustNotExist(), Readable() and Writable() methods.
+func DirPath(longName, usage string) *core.DirPathFlag {
+	return DefaultBucket.DirPath(longName, usage)
+}
+
+// Endpoint adds a new network endpoint flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. listen).
+//
+// The value of an endpoint flag can be specified in "host:port" or "[ipv6]:port" format (i.e. "localhost:8080" or "[::1]:8080").
+// The default port, the acceptable port range and the host requirement can be defined using
+// WithDefaultPort(), WithPortRange() and RequireHost() methods. The unix domain socket endpoints
+// (i.e. "unix:///var/run/app.sock") can be allowed using AllowUnix() method.
+func Endpoint(longName, usage string) *core.EndpointFlag {
+	return DefaultBucket.Endpoint(longName, usage)
+}
+
+// EndpointSlice adds a new network endpoint slice flag to the default bucket.
+//
+// The long name will be automatically converted to lowercase by the library (i.e. brokers).
+//
+// The value of an endpoint slice flag can be specified using a comma (or any custom delimiter) separated string of
+// endpoints in "host:port" or "[ipv6]:port" format (i.e. "10.0.0.1:9092, [::1]:9092").
+//
+// A custom delimiter string can be defined using WithDelimiter() method.
+func EndpointSlice(longName, usage string) *core.EndpointSliceFlag {
+	return DefaultBucket.EndpointSlice(longName, usage)
+}
+
 // CIDR adds a new CIDR flag to the default bucket.
 //
 // The long name will be automatically converted to lowercase by the library (i.e. network).
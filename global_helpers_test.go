@@ -35,6 +35,13 @@ func TestSetLogger(t *testing.T) {
 	}
 }
 
+func TestEnableLogLevelFlag(t *testing.T) {
+	EnableLogLevelFlag()
+	if !DefaultBucket.logLevelFlag {
+		t.Error("The default bucket's log level flag was expected to be enabled")
+	}
+}
+
 func TestSetSortOrder(t *testing.T) {
 	expected := by.KeyAscending
 	SetSortOrder(expected)
@@ -44,6 +51,73 @@ func TestSetSortOrder(t *testing.T) {
 	}
 }
 
+func TestSetGroupOrder(t *testing.T) {
+	SetGroupOrder("b", "a")
+	actual := DefaultBucket.opts.GroupOrder
+	if !reflect.DeepEqual(actual, []string{"b", "a"}) {
+		t.Errorf("The default bucket's group order was expected to be [b a], but it was %v", actual)
+	}
+}
+
+func TestSetGroupSortOrder(t *testing.T) {
+	expected := by.KeyAscending
+	SetGroupSortOrder("group", expected)
+	actual := DefaultBucket.opts.GroupComparers["group"]
+	if actual != expected {
+		t.Errorf("The group's sort order was expected to be %T, but it was %T", expected, actual)
+	}
+}
+
+func TestSetDefaultGroupTitle(t *testing.T) {
+	expected := "Other"
+	SetDefaultGroupTitle(expected)
+	actual := DefaultBucket.opts.DefaultGroupTitle
+	if actual != expected {
+		t.Errorf("The default group title was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetKeyFormatString(t *testing.T) {
+	expected := "env: %s"
+	SetKeyFormatString(expected)
+	actual := DefaultBucket.opts.KeyFormatString
+	if actual != expected {
+		t.Errorf("The default bucket's key format string was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetEnvPresenceMark(t *testing.T) {
+	expected := "(set)"
+	SetEnvPresenceMark(expected)
+	actual := DefaultBucket.opts.EnvPresenceMark
+	if actual != expected {
+		t.Errorf("The default bucket's environment presence mark was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	expected := &core.Theme{FlagName: core.Bold}
+	SetTheme(expected)
+	actual := DefaultBucket.opts.Theme
+	if actual != expected {
+		t.Errorf("The default bucket's theme was expected to be %v, but it was %v", expected, actual)
+	}
+}
+
+func TestSetMessageCatalogue(t *testing.T) {
+	expected := core.MessageMap{core.MsgRequired: "%s ist erforderlich."}
+	SetMessageCatalogue(expected)
+	actual, ok := DefaultBucket.opts.Messages.(core.MessageMap)
+	if !ok || actual[core.MsgRequired] != expected[core.MsgRequired] {
+		t.Errorf("The default bucket's message catalogue was expected to be %v, but it was %v", expected, DefaultBucket.opts.Messages)
+	}
+
+	SetMessageCatalogue(nil)
+	if _, ok := DefaultBucket.opts.Messages.(core.EnglishCatalogue); !ok {
+		t.Errorf("The default bucket's message catalogue was expected to be reset to English, but it was %v", DefaultBucket.opts.Messages)
+	}
+}
+
 func TestSetHelpFormatter(t *testing.T) {
 	expected := &core.TabbedHelpFormatter{}
 	SetHelpFormatter(expected)
@@ -138,6 +212,26 @@ func TestSetPostSetCallback(t *testing.T) {
 	}
 }
 
+func TestAddValidator(t *testing.T) {
+	DefaultBucket = NewBucket()
+	AddValidator(nil)
+	AddValidator(func(set core.FlagSet) error { return nil })
+	if len(DefaultBucket.opts.Validators) != 1 {
+		t.Errorf("Expected the default bucket to have 1 cross flag validator, Actual: %d", len(DefaultBucket.opts.Validators))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	DefaultBucket = NewBucket()
+	flag := Int("port-number", "usage")
+	if actual := Lookup("Port-Number"); actual != flag {
+		t.Errorf("Expected the port-number flag, Actual: %v", actual)
+	}
+	if actual := Lookup("unknown"); actual != nil {
+		t.Errorf("Expected nil for an unregistered flag, Actual: %v", actual)
+	}
+}
+
 func TestAppendSource(t *testing.T) {
 	DefaultBucket = NewBucket()
 	src := NewMemorySource()
@@ -269,6 +363,89 @@ func TestAddSource(t *testing.T) {
 	}
 }
 
+func TestSetProgramName(t *testing.T) {
+	expected := "tool"
+	SetProgramName(expected)
+	actual := DefaultBucket.opts.ProgramName
+	if actual != expected {
+		t.Errorf("The default bucket's program name was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetSynopsis(t *testing.T) {
+	expected := "tool [flags] <file>"
+	SetSynopsis(expected)
+	actual := DefaultBucket.opts.Synopsis
+	if actual != expected {
+		t.Errorf("The default bucket's synopsis was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetDescription(t *testing.T) {
+	expected := "description"
+	SetDescription(expected)
+	actual := DefaultBucket.opts.Description
+	if actual != expected {
+		t.Errorf("The default bucket's description was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestAddExample(t *testing.T) {
+	DefaultBucket.opts.Examples = nil
+	AddExample("tool --port 80", "Listens on port 80")
+	AddExample("tool -h", "")
+	actual := DefaultBucket.opts.Examples
+	if len(actual) != 2 {
+		t.Fatalf("The default bucket was expected to have 2 examples, but it had %d", len(actual))
+	}
+	if actual[0].Command != "tool --port 80" || actual[0].Description != "Listens on port 80" || actual[1].Command != "tool -h" {
+		t.Errorf("Unexpected examples: %+v", actual)
+	}
+}
+
+func TestSetFooter(t *testing.T) {
+	expected := "footer"
+	SetFooter(expected)
+	actual := DefaultBucket.opts.Footer
+	if actual != expected {
+		t.Errorf("The default bucket's footer was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestSetVersionTemplate(t *testing.T) {
+	expected := "{{.Version}}"
+	SetVersionTemplate(expected)
+	actual := DefaultBucket.opts.VersionTemplate
+	if actual != expected {
+		t.Errorf("The default bucket's version template was expected to be %s, but it was %s", expected, actual)
+	}
+}
+
+func TestGlobalVersion(t *testing.T) {
+	DefaultBucket = NewBucket()
+	info := Version("1.0.0")
+	if DefaultBucket.version != info {
+		t.Error("The default bucket's version information has not been set as expected")
+	}
+	if info.Version != "1.0.0" {
+		t.Errorf("Expected version 1.0.0, but received %s", info.Version)
+	}
+	if !DefaultBucket.reg.isReserved("--version") || !DefaultBucket.reg.isReserved("-V") {
+		t.Error("The version flags were expected to be reserved")
+	}
+}
+
+func TestGlobalVersionFromBuildInfo(t *testing.T) {
+	DefaultBucket = NewBucket()
+	info := VersionFromBuildInfo()
+	if DefaultBucket.version != info {
+		t.Error("The default bucket's version information has not been set as expected")
+	}
+	if !DefaultBucket.reg.isReserved("--version") || !DefaultBucket.reg.isReserved("-V") {
+		t.Error("The version flags were expected to be reserved")
+	}
+}
+
 func TestParse(t *testing.T) {
 	DefaultBucket = NewBucket()
 	DefaultBucket.Options().Terminator = &mocks.Terminator{}
@@ -561,6 +738,32 @@ func TestGlobalBoolSlice(t *testing.T) {
 	}
 }
 
+func TestGlobalByteSize(t *testing.T) {
+	DefaultBucket = NewBucket()
+	ByteSize("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.ByteSizeFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.ByteSizeFlag{}, f)
+	}
+}
+
+func TestGlobalByteSizeSlice(t *testing.T) {
+	DefaultBucket = NewBucket()
+	ByteSizeSlice("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.ByteSizeSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.ByteSizeSliceFlag{}, f)
+	}
+}
+
 func TestGlobalTime(t *testing.T) {
 	DefaultBucket = NewBucket()
 	Time("long", "usage")
@@ -652,6 +855,84 @@ func TestGlobalIPAddressSlice(t *testing.T) {
 	}
 }
 
+func TestGlobalURL(t *testing.T) {
+	DefaultBucket = NewBucket()
+	URL("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.URLFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.URLFlag{}, f)
+	}
+}
+
+func TestGlobalURLSlice(t *testing.T) {
+	DefaultBucket = NewBucket()
+	URLSlice("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.URLSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.URLSliceFlag{}, f)
+	}
+}
+
+func TestGlobalFilePath(t *testing.T) {
+	DefaultBucket = NewBucket()
+	FilePath("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.FilePathFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.FilePathFlag{}, f)
+	}
+}
+
+func TestGlobalDirPath(t *testing.T) {
+	DefaultBucket = NewBucket()
+	DirPath("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.DirPathFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.DirPathFlag{}, f)
+	}
+}
+
+func TestGlobalEndpoint(t *testing.T) {
+	DefaultBucket = NewBucket()
+	Endpoint("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.EndpointFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.EndpointFlag{}, f)
+	}
+}
+
+func TestGlobalEndpointSlice(t *testing.T) {
+	DefaultBucket = NewBucket()
+	EndpointSlice("long", "usage")
+	actual := len(DefaultBucket.Flags())
+	if actual != 1 {
+		t.Errorf("Expected to get 1 parsed flag, but received %d", actual)
+	}
+	f := DefaultBucket.Flags()[0]
+	if _, ok := f.(*core.EndpointSliceFlag); !ok {
+		t.Errorf("Expected %T, but received %T", &core.EndpointSliceFlag{}, f)
+	}
+}
+
 func TestGlobalCIDR(t *testing.T) {
 	DefaultBucket = NewBucket()
 	CIDR("long", "usage")
@@ -677,3 +958,15 @@ func TestGlobalCIDRSlice(t *testing.T) {
 		t.Errorf("Expected %T, but received %T", &core.CIDRSliceFlag{}, f)
 	}
 }
+
+func TestGlobalFromFile(t *testing.T) {
+	DefaultBucket = NewBucket()
+	FromFile("long", core.WithMaxFileSize(10), core.TrimFileContent())
+	fc, ok := DefaultBucket.fileContents["long"]
+	if !ok {
+		t.Fatal("Expected the file content settings to be registered, but they were not")
+	}
+	if fc.MaxSize != 10 || !fc.Trim {
+		t.Errorf("Expected MaxSize: 10 and Trim: true, Actual: %d and %v", fc.MaxSize, fc.Trim)
+	}
+}
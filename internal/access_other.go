@@ -0,0 +1,45 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+// IsReadable returns true if the path can be opened for reading.
+func IsReadable(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	_ = f.Close()
+	return true
+}
+
+// IsWritable returns true if the path can be opened for writing.
+//
+// The directories are checked by creating a temporary file within them.
+func IsWritable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	if !info.IsDir() {
+		f, err := os.OpenFile(path, os.O_WRONLY, 0)
+		if err != nil {
+			return false
+		}
+		_ = f.Close()
+		return true
+	}
+	f, err := ioutil.TempFile(path, ".access-")
+	if err != nil {
+		return false
+	}
+	name := f.Name()
+	_ = f.Close()
+	_ = os.Remove(name)
+	return true
+}
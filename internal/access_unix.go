@@ -0,0 +1,21 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+// +build linux darwin freebsd netbsd openbsd dragonfly
+
+package internal
+
+import "syscall"
+
+const (
+	accessRead  = 0x4
+	accessWrite = 0x2
+)
+
+// IsReadable returns true if the current user has read access to the path.
+func IsReadable(path string) bool {
+	return syscall.Access(path, accessRead) == nil
+}
+
+// IsWritable returns true if the current user has write access to the path.
+func IsWritable(path string) bool {
+	return syscall.Access(path, accessWrite) == nil
+}
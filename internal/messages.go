@@ -0,0 +1,282 @@
+package internal
+
+import "fmt"
+
+// The identifiers of the user-facing messages.
+const (
+	MsgInvalidValue         = "error.invalid_value"
+	MsgOutOfRange           = "error.out_of_range"
+	MsgOutOfRangeValue      = "error.out_of_range.value"
+	MsgOutOfRangeValues     = "error.out_of_range.values"
+	MsgOutOfRangeTime       = "error.out_of_range.time"
+	MsgOutOfBounds          = "error.out_of_bounds"
+	MsgEmptyValue           = "error.empty_value"
+	MsgLengthOutOfBounds    = "error.length_out_of_bounds"
+	MsgPatternMismatch      = "error.pattern_mismatch"
+	MsgInvalidFormat        = "error.invalid_format"
+	MsgItemCountOutOfBounds = "error.item_count_out_of_bounds"
+	MsgDuplicateItem        = "error.duplicate_item"
+	MsgInvalidScheme        = "error.invalid_scheme"
+	MsgMissingHost          = "error.missing_host"
+	MsgMissingPort          = "error.missing_port"
+	MsgPortOutOfBounds      = "error.port_out_of_bounds"
+	MsgPathNotFound         = "error.path_not_found"
+	MsgPathExists           = "error.path_exists"
+	MsgPathNotReadable      = "error.path_not_readable"
+	MsgPathNotWritable      = "error.path_not_writable"
+	MsgNotDirectory         = "error.not_directory"
+	MsgIsDirectory          = "error.is_directory"
+	MsgInvalidExtension     = "error.invalid_extension"
+	MsgFileRead             = "error.file_read"
+	MsgFileTooLarge         = "error.file_too_large"
+	MsgValidationFailed     = "error.validation_failed"
+	MsgValidateRange        = "validate.range"
+	MsgValidatePattern      = "validate.pattern"
+	MsgValidateOneOf        = "validate.one_of"
+	MsgValidateNot          = "validate.not"
+	MsgValidateAny          = "validate.any"
+	MsgValidateType         = "validate.type"
+	MsgRequired             = "error.required"
+	MsgDeprecatedRequired   = "error.deprecated_required"
+	MsgUnknownFlag          = "error.unknown_flag"
+	MsgReservedFlag         = "error.reserved_flag"
+	MsgFlagExists           = "error.flag_exists"
+	MsgInvalidShortName     = "error.invalid_short_name"
+	MsgKeyExists            = "error.key_exists"
+	MsgSelfReplacement      = "error.self_replacement"
+	MsgMissingReplacement   = "error.missing_replacement"
+	MsgInvalidDefault       = "error.invalid_default"
+	MsgInvalidRange         = "error.invalid_range"
+	MsgInvalidConstraint    = "error.invalid_constraint"
+	MsgUnknownFileFlag      = "error.unknown_file_flag"
+	MsgUnsupportedShell     = "error.unsupported_shell"
+	MsgDeprecated           = "warning.deprecated"
+	MsgUseReplacement       = "warning.use_replacement"
+	MsgDefaultValue         = "help.default_value"
+	MsgDeprecationMark      = "help.deprecation_mark"
+	MsgDefaultGroupTitle    = "help.default_group"
+	MsgHelpUsage            = "help.usage.help"
+	MsgVersionUsage         = "help.usage.version"
+	MsgUsageHeading         = "help.heading.usage"
+	MsgFlagsHeading         = "help.heading.flags"
+	MsgExamplesHeading      = "help.heading.examples"
+	MsgEnvironmentLabel     = "help.label.environment"
+	MsgDefaultLabel         = "help.label.default"
+	MsgValidValuesLabel     = "help.label.valid_values"
+	MsgRangeLabel           = "help.label.range"
+	MsgConstraintsLabel     = "help.label.constraints"
+	MsgGroupLabel           = "help.label.group"
+	MsgStatusLabel          = "help.label.status"
+	MsgDeprecatedLabel      = "help.label.deprecated"
+	MsgCurrentValueLabel    = "help.label.current_value"
+	MsgSourceLabel          = "help.label.source"
+	MsgStatusRequired       = "help.status.required"
+	MsgStatusDeprecated     = "help.status.deprecated"
+	MsgStatusHidden         = "help.status.hidden"
+	MsgOneOf                = "help.constraint.one_of"
+	MsgRange                = "help.constraint.range"
+	MsgNonEmpty             = "help.constraint.non_empty"
+	MsgLength               = "help.constraint.length"
+	MsgPattern              = "help.constraint.pattern"
+	MsgFormat               = "help.constraint.format"
+	MsgItems                = "help.constraint.items"
+	MsgUnique               = "help.constraint.unique"
+	MsgSchemes              = "help.constraint.schemes"
+	MsgHostRequired         = "help.constraint.host_required"
+	MsgPort                 = "help.constraint.port"
+	MsgMustExist            = "help.constraint.must_exist"
+	MsgMustNotExist         = "help.constraint.must_not_exist"
+	MsgReadable             = "help.constraint.readable"
+	MsgWritable             = "help.constraint.writable"
+	MsgExtensions           = "help.constraint.extensions"
+	MsgNot                  = "help.constraint.not"
+	MsgAll                  = "help.constraint.all"
+	MsgAny                  = "help.constraint.any"
+	MsgRequiredNote         = "docs.required"
+	MsgDeprecatedNote       = "docs.deprecated"
+	MsgManualTitle          = "docs.manual"
+	MsgNameSection          = "docs.section.name"
+	MsgSynopsisSection      = "docs.section.synopsis"
+	MsgDescriptionSection   = "docs.section.description"
+	MsgOptionsSection       = "docs.section.options"
+	MsgFlagsSection         = "docs.section.flags"
+	MsgFlagColumn           = "docs.column.flag"
+	MsgTypeColumn           = "docs.column.type"
+	MsgEnvironmentColumn    = "docs.column.environment"
+	MsgDefaultColumn        = "docs.column.default"
+	MsgDescriptionColumn    = "docs.column.description"
+	MsgOriginUnset          = "origin.unset"
+	MsgOriginDefault        = "origin.default"
+	MsgOriginCommandLine    = "origin.command_line"
+	MsgOriginEnvironment    = "origin.environment"
+	MsgOriginSource         = "origin.source"
+	MsgOriginForwarded      = "origin.forwarded"
+)
+
+// EnglishMessages holds the English format strings of the user-facing messages.
+var EnglishMessages = map[string]string{
+	MsgInvalidValue:         "'%v' is not a valid %s value for %s",
+	MsgOutOfRange:           "%v is not an acceptable value for %s.",
+	MsgOutOfRangeValue:      "%v is not an acceptable value for %s. The expected value is %s.",
+	MsgOutOfRangeValues:     "%v is not an acceptable value for %s. The expected values are %s.",
+	MsgOutOfRangeTime:       "%v is not an acceptable value for --%s. You must pick a value from %s.",
+	MsgOutOfBounds:          "%v is not an acceptable value for %s. The expected range is %s.",
+	MsgEmptyValue:           "%s cannot be empty.",
+	MsgLengthOutOfBounds:    "'%v' is not an acceptable value for %s. The expected length is %s.",
+	MsgPatternMismatch:      "'%v' is not an acceptable value for %s. The value must match the %s pattern.",
+	MsgInvalidFormat:        "'%v' is not a valid %s for %s.",
+	MsgItemCountOutOfBounds: "%d items are not acceptable for %s. The expected number of items is %s.",
+	MsgDuplicateItem:        "'%v' has been provided more than once for %s. The items must be unique.",
+	MsgInvalidScheme:        "'%v' is not an acceptable value for %s. The scheme must be one of %s.",
+	MsgMissingHost:          "'%v' is not an acceptable value for %s. The host cannot be empty.",
+	MsgMissingPort:          "'%v' is not an acceptable value for %s. The port cannot be empty.",
+	MsgPortOutOfBounds:      "'%v' is not an acceptable value for %s. The expected port range is %s.",
+	MsgPathNotFound:         "'%v' is not an acceptable value for %s. The path does not exist.",
+	MsgPathExists:           "'%v' is not an acceptable value for %s. The path already exists.",
+	MsgPathNotReadable:      "'%v' is not an acceptable value for %s. The path is not readable.",
+	MsgPathNotWritable:      "'%v' is not an acceptable value for %s. The path is not writable.",
+	MsgNotDirectory:         "'%v' is not an acceptable value for %s. The path is not a directory.",
+	MsgIsDirectory:          "'%v' is not an acceptable value for %s. The path is a directory.",
+	MsgInvalidExtension:     "'%v' is not an acceptable value for %s. The expected extensions are %s.",
+	MsgFileRead:             "The value of %s cannot be read from %s: %v.",
+	MsgFileTooLarge:         "%s is too large to be used as the value of %s. The maximum size is %v.",
+	MsgValidationFailed:     "%v is not an acceptable value for %s: %v.",
+	MsgValidateRange:        "the expected range is %s",
+	MsgValidatePattern:      "the value must match the %s pattern",
+	MsgValidateOneOf:        "the expected values are %s",
+	MsgValidateNot:          "the value must not satisfy %s",
+	MsgValidateAny:          "the value must satisfy at least one of %s",
+	MsgValidateType:         "the value cannot be compared with %v",
+	MsgRequired:             "%s flag is required.",
+	MsgDeprecatedRequired:   "%s is marked as deprecated. An obsolete flag cannot be mandatory",
+	MsgUnknownFlag:          "%s is an unknown flag",
+	MsgReservedFlag:         "is a reserved flag",
+	MsgFlagExists:           "flag already exists",
+	MsgInvalidShortName:     "is not a valid short form. The short form can only be a single character",
+	MsgKeyExists:            "flag key already exists",
+	MsgSelfReplacement:      "cannot be replaced by itself",
+	MsgMissingReplacement:   "replacement flag --%s does not exist",
+	MsgInvalidDefault:       "has an invalid default value. %v",
+	MsgInvalidRange:         "has an invalid range of acceptable values %s. No value can fall within the range.",
+	MsgInvalidConstraint:    "has an invalid constraint (%s). No value can satisfy the constraint.",
+	MsgUnknownFileFlag:      "cannot be read from a file. The flag does not exist",
+	MsgUnsupportedShell:     "%s is not a supported shell. The supported shells are %s, %s and %s",
+	MsgDeprecated:           "%s is deprecated.",
+	MsgUseReplacement:       "Use --%s instead.",
+	MsgDefaultValue:         "(default: %v)",
+	MsgDeprecationMark:      "[DEPRECATED]",
+	MsgDefaultGroupTitle:    "General",
+	MsgHelpUsage:            "Show help",
+	MsgVersionUsage:         "Print version information",
+	MsgUsageHeading:         "Usage:",
+	MsgFlagsHeading:         "Flags:",
+	MsgExamplesHeading:      "Examples:",
+	MsgEnvironmentLabel:     "Environment:",
+	MsgDefaultLabel:         "Default:",
+	MsgValidValuesLabel:     "Valid values:",
+	MsgRangeLabel:           "Range:",
+	MsgConstraintsLabel:     "Constraints:",
+	MsgGroupLabel:           "Group:",
+	MsgStatusLabel:          "Status:",
+	MsgDeprecatedLabel:      "Deprecated:",
+	MsgCurrentValueLabel:    "Current value:",
+	MsgSourceLabel:          "Source:",
+	MsgStatusRequired:       "required",
+	MsgStatusDeprecated:     "deprecated",
+	MsgStatusHidden:         "hidden",
+	MsgOneOf:                "one of: %s",
+	MsgRange:                "range: %s",
+	MsgNonEmpty:             "non-empty",
+	MsgLength:               "length: %s",
+	MsgPattern:              "pattern: %s",
+	MsgFormat:               "format: %s",
+	MsgItems:                "items: %s",
+	MsgUnique:               "unique",
+	MsgSchemes:              "schemes: %s",
+	MsgHostRequired:         "host required",
+	MsgPort:                 "port: %s",
+	MsgMustExist:            "must exist",
+	MsgMustNotExist:         "must not exist",
+	MsgReadable:             "readable",
+	MsgWritable:             "writable",
+	MsgExtensions:           "extensions: %s",
+	MsgNot:                  "not (%s)",
+	MsgAll:                  "all of (%s)",
+	MsgAny:                  "any of (%s)",
+	MsgRequiredNote:         "Required.",
+	MsgDeprecatedNote:       "Deprecated.",
+	MsgManualTitle:          "User Commands",
+	MsgNameSection:          "Name",
+	MsgSynopsisSection:      "Synopsis",
+	MsgDescriptionSection:   "Description",
+	MsgOptionsSection:       "Options",
+	MsgFlagsSection:         "Flags",
+	MsgFlagColumn:           "Flag",
+	MsgTypeColumn:           "Type",
+	MsgEnvironmentColumn:    "Environment Variable",
+	MsgDefaultColumn:        "Default",
+	MsgDescriptionColumn:    "Description",
+	MsgOriginUnset:          "unset",
+	MsgOriginDefault:        "default",
+	MsgOriginCommandLine:    "command line",
+	MsgOriginEnvironment:    "environment",
+	MsgOriginSource:         "source",
+	MsgOriginForwarded:      "forwarded",
+}
+
+// FormatMessage formats the message using the format string and the arguments.
+//
+// The format string will be returned as is if there is no argument, so that the messages which are format strings
+// themselves (i.e. "(default: %v)") can be retrieved.
+func FormatMessage(format string, args ...interface{}) string {
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// EnglishMessage returns the English text of the message with the specified identifier.
+//
+// The identifier will be returned if the message does not exist.
+func EnglishMessage(id string, args ...interface{}) string {
+	format, ok := EnglishMessages[id]
+	if !ok {
+		return id
+	}
+	return FormatMessage(format, args...)
+}
+
+// MessageErr represents an error with a message identifier which can be translated.
+type MessageErr struct {
+	id   string
+	args []interface{}
+	text string
+}
+
+// NewMessageErr creates a new error with the English text of the message.
+func NewMessageErr(id string, args ...interface{}) *MessageErr {
+	return &MessageErr{
+		id:   id,
+		args: args,
+		text: EnglishMessage(id, args...),
+	}
+}
+
+// Error returns the text of the error.
+func (e *MessageErr) Error() string {
+	return e.text
+}
+
+// Localise translates the error message using the specified message function.
+//
+// The translatable errors within the arguments will also be translated.
+func (e *MessageErr) Localise(message func(id string, args ...interface{}) string) {
+	for _, arg := range e.args {
+		if l, ok := arg.(interface {
+			Localise(message func(id string, args ...interface{}) string)
+		}); ok {
+			l.Localise(message)
+		}
+	}
+	e.text = message(e.id, e.args...)
+}
@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TerminalWidth returns the width of the terminal to which w is attached, or zero if w is not a terminal.
+func TerminalWidth(w io.Writer) int {
+	if f, ok := w.(*os.File); ok {
+		return terminalWidth(f.Fd())
+	}
+	return 0
+}
+
+// ColumnsWidth returns the value of the COLUMNS environment variable.
+//
+// The function returns zero if the variable is not set or it is not a positive number.
+func ColumnsWidth(env EnvironmentVariableReader) int {
+	if env == nil {
+		return 0
+	}
+	if columns, ok := env.Get("COLUMNS"); ok {
+		if width, err := strconv.Atoi(strings.TrimSpace(columns)); err == nil && width > 0 {
+			return width
+		}
+	}
+	return 0
+}
+
+// IsTerminal returns true if w is attached to a terminal.
+func IsTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && terminalWidth(f.Fd()) > 0
+}
+
+// VisibleWidth returns the number of visible characters in s, excluding the ANSI escape sequences.
+func VisibleWidth(s string) int {
+	return utf8.RuneCountInString(ansiEscape.ReplaceAllString(s, ""))
+}
+
+var ansiEscape = regexp.MustCompile("\x1b\\[[0-9;]*m")
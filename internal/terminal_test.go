@@ -0,0 +1,106 @@
+package internal_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xitonix/flags/internal"
+)
+
+type envReader map[string]string
+
+func (e envReader) Get(key string) (string, bool) {
+	value, ok := e[key]
+	return value, ok
+}
+
+func TestColumnsWidth(t *testing.T) {
+	testCases := []struct {
+		title    string
+		env      internal.EnvironmentVariableReader
+		expected int
+	}{
+		{
+			title: "nil environment variable reader",
+		},
+		{
+			title: "no COLUMNS variable",
+			env:   envReader{},
+		},
+		{
+			title:    "valid COLUMNS variable",
+			env:      envReader{"COLUMNS": " 120 "},
+			expected: 120,
+		},
+		{
+			title: "invalid COLUMNS variable",
+			env:   envReader{"COLUMNS": "wide"},
+		},
+		{
+			title: "negative COLUMNS variable",
+			env:   envReader{"COLUMNS": "-10"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := internal.ColumnsWidth(tc.env)
+			if actual != tc.expected {
+				t.Errorf("Expected width: %d, Actual: %d", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTerminalWidth(t *testing.T) {
+	if width := internal.TerminalWidth(&bytes.Buffer{}); width != 0 {
+		t.Errorf("Expected the width of an in-memory buffer to be zero, Actual: %d", width)
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	if internal.IsTerminal(&bytes.Buffer{}) {
+		t.Errorf("Did not expect an in-memory buffer to be a terminal")
+	}
+}
+
+func TestVisibleWidth(t *testing.T) {
+	testCases := []struct {
+		title    string
+		input    string
+		expected int
+	}{
+		{
+			title: "empty input",
+		},
+		{
+			title:    "plain text",
+			input:    "--port",
+			expected: 6,
+		},
+		{
+			title:    "styled text",
+			input:    "\x1b[1;31m--port\x1b[0m",
+			expected: 6,
+		},
+		{
+			title:    "styled empty text",
+			input:    "\x1b[1m\x1b[0m",
+			expected: 0,
+		},
+		{
+			title:    "multi-byte characters",
+			input:    "\x1b[2mdurée\x1b[0m",
+			expected: 5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			actual := internal.VisibleWidth(tc.input)
+			if actual != tc.expected {
+				t.Errorf("Expected width: %d, Actual: %d", tc.expected, actual)
+			}
+		})
+	}
+}
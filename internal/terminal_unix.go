@@ -0,0 +1,22 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd || dragonfly
+// +build linux darwin freebsd netbsd openbsd dragonfly
+
+package internal
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+type winSize struct {
+	rows, cols, xPixels, yPixels uint16
+}
+
+func terminalWidth(fd uintptr) int {
+	ws := &winSize{}
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(ws)))
+	if errno != 0 {
+		return 0
+	}
+	return int(ws.cols)
+}
@@ -1,7 +1,6 @@
 package internal
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 )
@@ -32,20 +31,25 @@ func SanitiseShortName(name string) string {
 
 // OutOfRangeErr creates a new out of range error.
 func OutOfRangeErr(value interface{}, longName, shortName string, valid []string) error {
-	if len(valid) == 0 {
-		return fmt.Errorf("%v is not an acceptable value for %s.", value, GetPrintName(longName, shortName))
+	name := GetPrintName(longName, shortName)
+	switch len(valid) {
+	case 0:
+		return NewMessageErr(MsgOutOfRange, value, name)
+	case 1:
+		return NewMessageErr(MsgOutOfRangeValue, value, name, valid[0])
+	default:
+		return NewMessageErr(MsgOutOfRangeValues, value, name, strings.Join(valid, ","))
 	}
+}
 
-	plural := " is"
-	if len(valid) > 1 {
-		plural = "s are"
-	}
-	return fmt.Errorf("%v is not an acceptable value for %s. The expected value%s %s.", value, GetPrintName(longName, shortName), plural, strings.Join(valid, ","))
+// OutOfBoundsErr creates a new error for the values which do not fall within the acceptable range (i.e. [1, 65535]).
+func OutOfBoundsErr(value interface{}, longName, shortName, valueRange string) error {
+	return NewMessageErr(MsgOutOfBounds, value, GetPrintName(longName, shortName), valueRange)
 }
 
 // InvalidValueErr creates a new invalid flag value error.
 func InvalidValueErr(value interface{}, longName, shortName, flagType string) error {
-	return fmt.Errorf("'%v' is not a valid %s value for %s", value, flagType, GetPrintName(longName, shortName))
+	return NewMessageErr(MsgInvalidValue, value, flagType, GetPrintName(longName, shortName))
 }
 
 // GetPrintName returns the print name of the flag (i.e "-F, --flag")
@@ -9,9 +9,10 @@ type Flag struct {
 	long, short   string
 	value         interface{}
 	isSet         bool
-	isDeprecated  bool
+	deprecation   *core.Deprecation
 	isRequired    bool
 	isHidden      bool
+	group         string
 	hasDefault    bool
 	defaultValue  string
 	MakeSetToFail bool
@@ -67,7 +68,7 @@ func (f *Flag) IsHidden() bool {
 
 // IsDeprecated returns true if the flag is marked as deprecated
 func (f *Flag) IsDeprecated() bool {
-	return f.isDeprecated
+	return f.deprecation != nil
 }
 
 // IsRequired returns true if the flag value must be provided.
@@ -123,11 +124,30 @@ func (f *Flag) Default() interface{} {
 }
 
 // MarkAsDeprecated marks the flag as deprecated.
-func (f *Flag) MarkAsDeprecated() *Flag {
-	f.isDeprecated = true
+func (f *Flag) MarkAsDeprecated(options ...core.DeprecationOption) *Flag {
+	f.deprecation = &core.Deprecation{}
+	for _, option := range options {
+		option(f.deprecation)
+	}
+	return f
+}
+
+// Deprecation returns the deprecation details of the flag.
+func (f *Flag) Deprecation() *core.Deprecation {
+	return f.deprecation
+}
+
+// WithGroup assigns the flag to a named group.
+func (f *Flag) WithGroup(name string) *Flag {
+	f.group = name
 	return f
 }
 
+// Group returns the name of the group to which the flag belongs.
+func (f *Flag) Group() string {
+	return f.group
+}
+
 // SetHidden marks the flag as hidden.
 func (f *Flag) SetHidden(hidden bool) {
 	f.isHidden = hidden
@@ -1,5 +1,7 @@
 package mocks
 
+import "github.com/xitonix/flags/core"
+
 // Logger represents a mocked logger.
 type Logger struct {
 	Error error
@@ -9,3 +11,27 @@ type Logger struct {
 func (l *Logger) Print(err error) {
 	l.Error = err
 }
+
+// LeveledLogger represents a mocked leveled logger.
+type LeveledLogger struct {
+	Logger
+	Level  core.Level
+	Events []string
+}
+
+// Enabled returns true if the level is greater than or equal to the minimum level of the logger.
+func (l *LeveledLogger) Enabled(level core.Level) bool {
+	return level >= l.Level
+}
+
+// Log records the formatted event.
+func (l *LeveledLogger) Log(level core.Level, msg string, attrs ...core.Attr) {
+	if l.Enabled(level) {
+		l.Events = append(l.Events, core.FormatEvent(msg, attrs...))
+	}
+}
+
+// SetLevel sets the minimum level of the logger.
+func (l *LeveledLogger) SetLevel(level core.Level) {
+	l.Level = level
+}
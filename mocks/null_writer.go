@@ -9,6 +9,8 @@ type InMemoryWriter struct {
 	Lines             []string
 	ForceWriteToBreak bool
 	ForceCloseToBreak bool
+	// TerminalWidth is the width which has been set by the bucket.
+	TerminalWidth int
 }
 
 // NewInMemoryWriter creates a new in-memory writer object
@@ -36,3 +38,8 @@ func (w *InMemoryWriter) Close() error {
 	w.IsClosed = true
 	return nil
 }
+
+// SetTerminalWidth records the terminal width.
+func (w *InMemoryWriter) SetTerminalWidth(width int) {
+	w.TerminalWidth = width
+}
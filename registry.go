@@ -9,18 +9,23 @@ import (
 
 type registry struct {
 	catalogue map[string]interface{}
+	reserved  map[string]interface{}
 }
 
-var (
-	reserved = map[string]interface{}{
-		"-h":     nil,
-		"--help": nil,
-	}
-)
-
 func newRegistry() *registry {
 	return &registry{
 		catalogue: make(map[string]interface{}),
+		reserved: map[string]interface{}{
+			"-h":     nil,
+			"--help": nil,
+		},
+	}
+}
+
+// reserve reserves the specified names for the built-in flags.
+func (r *registry) reserve(names ...string) {
+	for _, name := range names {
+		r.reserved[name] = nil
 	}
 }
 
@@ -34,11 +39,11 @@ func (r *registry) addLongNameIfValid(longName string) error {
 	long = "--" + long
 
 	if r.isReserved(long) {
-		return core.NewInvalidFlagErr(long, "", "", "is a reserved flag")
+		return core.NewInvalidFlagMessageErr(long, "", "", core.MsgReservedFlag)
 	}
 
 	if _, ok := r.catalogue[long]; ok {
-		return core.NewInvalidFlagErr(long, "", "", "flag already exists")
+		return core.NewInvalidFlagMessageErr(long, "", "", core.MsgFlagExists)
 	}
 
 	r.catalogue[long] = nil
@@ -52,15 +57,15 @@ func (r *registry) addShortNameIfValid(shortName string) error {
 
 	shortName = "-" + shortName
 	if len(shortName) != 2 {
-		return core.NewInvalidFlagErr("", shortName, "", "is not a valid short form. The short form can only be a single character")
+		return core.NewInvalidFlagMessageErr("", shortName, "", core.MsgInvalidShortName)
 	}
 
 	if r.isReserved(shortName) {
-		return core.NewInvalidFlagErr("", shortName, "", "is a reserved flag")
+		return core.NewInvalidFlagMessageErr("", shortName, "", core.MsgReservedFlag)
 	}
 
 	if _, ok := r.catalogue[shortName]; ok {
-		return core.NewInvalidFlagErr("", shortName, "", "flag already exists")
+		return core.NewInvalidFlagMessageErr("", shortName, "", core.MsgFlagExists)
 	}
 	r.catalogue[shortName] = nil
 	return nil
@@ -71,7 +76,7 @@ func (r *registry) addKeyIfValid(key string) error {
 		return nil
 	}
 	if _, ok := r.catalogue[key]; ok {
-		return core.NewInvalidFlagErr("", "", key, "flag key already exists")
+		return core.NewInvalidFlagMessageErr("", "", key, core.MsgKeyExists)
 	}
 
 	if len(key) > 0 {
@@ -96,6 +101,6 @@ func (r *registry) isRegistered(arg string) bool {
 }
 
 func (r *registry) isReserved(name string) bool {
-	_, ok := reserved[name]
+	_, ok := r.reserved[name]
 	return ok
 }
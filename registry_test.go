@@ -101,6 +101,16 @@ func TestRegistry_Add(t *testing.T) {
 			first:            mocks.NewFlag("long", "H"),
 			expectedFirstErr: "",
 		},
+		{
+			title:            "version long flag is not reserved by default",
+			first:            mocks.NewFlag("version", "s"),
+			expectedFirstErr: "",
+		},
+		{
+			title:            "version short flag is not reserved by default",
+			first:            mocks.NewFlag("long", "V"),
+			expectedFirstErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -122,3 +132,47 @@ func TestRegistry_Add(t *testing.T) {
 		})
 	}
 }
+
+func TestRegistry_Reserve(t *testing.T) {
+	testCases := []struct {
+		title       string
+		reserved    []string
+		flag        core.Flag
+		expectedErr string
+	}{
+		{
+			title:       "no reserved names",
+			flag:        mocks.NewFlag("version", "V"),
+			expectedErr: "",
+		},
+		{
+			title:       "reserved long name",
+			reserved:    []string{"--version", "-V"},
+			flag:        mocks.NewFlag("version", "s"),
+			expectedErr: "--version is a reserved flag",
+		},
+		{
+			title:       "reserved short name",
+			reserved:    []string{"--version", "-V"},
+			flag:        mocks.NewFlag("long", "V"),
+			expectedErr: "-V is a reserved flag",
+		},
+		{
+			title:       "reserved short name is case sensitive",
+			reserved:    []string{"--version", "-V"},
+			flag:        mocks.NewFlag("long", "v"),
+			expectedErr: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			reg := newRegistry()
+			reg.reserve(tc.reserved...)
+			err := reg.add(tc.flag)
+			if !test.ErrorContains(err, tc.expectedErr) {
+				t.Errorf("Expected to get '%v' error, but received '%v'", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package validate
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+//
+// The second return value will be false if the values are not comparable.
+func compare(a, b interface{}) (int, bool) {
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case ta.Before(tb):
+			return -1, true
+		case ta.After(tb):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() {
+		return 0, false
+	}
+	if va.Kind() == reflect.String && vb.Kind() == reflect.String {
+		return strings.Compare(va.String(), vb.String()), true
+	}
+
+	ka, kb := kindOf(va), kindOf(vb)
+	if ka == reflect.Invalid || kb == reflect.Invalid {
+		return 0, false
+	}
+	switch {
+	case ka == reflect.Float64 || kb == reflect.Float64:
+		fa, fb := toFloat(va), toFloat(vb)
+		if math.IsNaN(fa) || math.IsNaN(fb) {
+			return 0, false
+		}
+		return compareFloat(fa, fb), true
+	case ka == reflect.Int64 && kb == reflect.Int64:
+		return compareInt(va.Int(), vb.Int()), true
+	case ka == reflect.Uint64 && kb == reflect.Uint64:
+		return compareUInt(va.Uint(), vb.Uint()), true
+	case ka == reflect.Int64:
+		// a is signed, b is unsigned
+		if va.Int() < 0 {
+			return -1, true
+		}
+		return compareUInt(uint64(va.Int()), vb.Uint()), true
+	default:
+		// a is unsigned, b is signed
+		if vb.Int() < 0 {
+			return 1, true
+		}
+		return compareUInt(va.Uint(), uint64(vb.Int())), true
+	}
+}
+
+// equal returns true if the values are equal.
+//
+// The numeric values will be compared numerically, otherwise the string representations will be compared.
+func equal(a, b interface{}) bool {
+	if c, ok := compare(a, b); ok {
+		return c == 0
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// kindOf returns the widest numeric kind of the value, or reflect.Invalid if the value is not a number.
+func kindOf(v reflect.Value) reflect.Kind {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint64
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	default:
+		return reflect.Invalid
+	}
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch kindOf(v) {
+	case reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint64:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareUInt(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,227 @@
+// Package validate provides composable validators which can be shared between different flag types.
+//
+// The validators can be attached to any flag using the WithValidators(...) method. Each validator carries a
+// human-readable description, which will be rendered as a constraint in the help output.
+//
+// Example:
+//
+// 	port := flags.Int("port", "Port number").WithValidators(
+// 		validate.Between(1, 65535),
+// 		validate.Not(validate.OneOf(22, 25)),
+// 	)
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/internal"
+)
+
+// validator represents a reusable validator.
+type validator struct {
+	description core.Constraint
+	validate    func(value interface{}) error
+}
+
+// Validate returns an error if the value is not acceptable.
+func (v *validator) Validate(value interface{}) error {
+	return v.validate(value)
+}
+
+// Describe returns the human-readable description of the validator.
+func (v *validator) Describe() core.Constraint {
+	return v.description
+}
+
+// Custom creates a new validator using the validation function.
+//
+// The description will be rendered in the help output (i.e. "must be an even number").
+func Custom(description string, validate func(value interface{}) error) core.Validator {
+	return &validator{
+		description: core.NewConstraint(description),
+		validate:    validate,
+	}
+}
+
+// All creates a validator which only accepts the values which are accepted by all the validators.
+//
+// The error of the first validator which rejects the value will be returned.
+func All(validators ...core.Validator) core.Validator {
+	return &validator{
+		description: core.NewConstraint(core.MsgAll, describe(validators)),
+		validate: func(value interface{}) error {
+			for _, v := range validators {
+				if err := v.Validate(value); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Any creates a validator which accepts the values which are accepted by at least one of the validators.
+func Any(validators ...core.Validator) core.Validator {
+	return &validator{
+		description: core.NewConstraint(core.MsgAny, describe(validators)),
+		validate: func(value interface{}) error {
+			for _, v := range validators {
+				if err := v.Validate(value); err == nil {
+					return nil
+				}
+			}
+			return internal.NewMessageErr(internal.MsgValidateAny, &constraintList{constraints: describe(validators)})
+		},
+	}
+}
+
+// Not creates a validator which only accepts the values which are rejected by the specified validator.
+func Not(v core.Validator) core.Validator {
+	return &validator{
+		description: core.NewConstraint(core.MsgNot, v.Describe()),
+		validate: func(value interface{}) error {
+			if err := v.Validate(value); err != nil {
+				return nil
+			}
+			return internal.NewMessageErr(internal.MsgValidateNot, &constraintList{constraints: []core.Constraint{v.Describe()}})
+		},
+	}
+}
+
+// Min creates a validator which only accepts the values greater than or equal to min.
+//
+// The bound is inclusive, unless core.Exclusive has been specified. The values will be compared numerically
+// (i.e. an int value can be compared with a float64 bound). The strings and the time values can also be compared.
+func Min(min interface{}, bound ...core.Bound) core.Validator {
+	r := &core.Range{Min: fmt.Sprint(min), MinBound: boundOf(bound)}
+	return newRange(r, min, nil)
+}
+
+// Max creates a validator which only accepts the values less than or equal to max.
+//
+// The bound is inclusive, unless core.Exclusive has been specified.
+func Max(max interface{}, bound ...core.Bound) core.Validator {
+	r := &core.Range{Max: fmt.Sprint(max), MaxBound: boundOf(bound)}
+	return newRange(r, nil, max)
+}
+
+// Between creates a validator which only accepts the values within the range.
+//
+// Both bounds are inclusive, unless core.Exclusive has been specified.
+func Between(min, max interface{}, bound ...core.Bound) core.Validator {
+	b := boundOf(bound)
+	r := &core.Range{Min: fmt.Sprint(min), Max: fmt.Sprint(max), MinBound: b, MaxBound: b}
+	return newRange(r, min, max)
+}
+
+// Pattern creates a validator which only accepts the values matching the regular expression.
+//
+// The non-string values will be converted to string before matching (i.e. the IP addresses).
+func Pattern(pattern *regexp.Regexp) core.Validator {
+	return &validator{
+		description: core.NewConstraint(core.MsgPattern, pattern),
+		validate: func(value interface{}) error {
+			if !pattern.MatchString(fmt.Sprint(value)) {
+				return internal.NewMessageErr(internal.MsgValidatePattern, pattern)
+			}
+			return nil
+		},
+	}
+}
+
+// OneOf creates a validator which only accepts the specified values.
+//
+// The numeric values will be compared numerically, so OneOf(80, 443) can be used with any integer flag.
+func OneOf(values ...interface{}) core.Validator {
+	items := make([]string, len(values))
+	for i, v := range values {
+		items[i] = fmt.Sprint(v)
+	}
+	list := strings.Join(items, ", ")
+	return &validator{
+		description: core.NewConstraint(core.MsgOneOf, list),
+		validate: func(value interface{}) error {
+			for _, v := range values {
+				if equal(value, v) {
+					return nil
+				}
+			}
+			return internal.NewMessageErr(internal.MsgValidateOneOf, list)
+		},
+	}
+}
+
+func newRange(r *core.Range, min, max interface{}) core.Validator {
+	return &validator{
+		description: core.NewConstraint(core.MsgRange, r),
+		validate: func(value interface{}) error {
+			if min != nil {
+				c, ok := compare(value, min)
+				if !ok {
+					return internal.NewMessageErr(internal.MsgValidateType, min)
+				}
+				if c < 0 || (c == 0 && r.MinBound == core.Exclusive) {
+					return internal.NewMessageErr(internal.MsgValidateRange, r)
+				}
+			}
+			if max != nil {
+				c, ok := compare(value, max)
+				if !ok {
+					return internal.NewMessageErr(internal.MsgValidateType, max)
+				}
+				if c > 0 || (c == 0 && r.MaxBound == core.Exclusive) {
+					return internal.NewMessageErr(internal.MsgValidateRange, r)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func boundOf(bound []core.Bound) core.Bound {
+	if len(bound) > 0 {
+		return bound[0]
+	}
+	return core.Inclusive
+}
+
+func describe(validators []core.Validator) []core.Constraint {
+	constraints := make([]core.Constraint, len(validators))
+	for i, v := range validators {
+		constraints[i] = v.Describe()
+	}
+	return constraints
+}
+
+// constraintList represents the descriptions of the validators within an error message.
+//
+// The descriptions will be translated along with the error message.
+type constraintList struct {
+	constraints []core.Constraint
+	catalogue   core.MessageCatalogue
+}
+
+// Localise sets the message function which will be used to translate the descriptions.
+func (l *constraintList) Localise(message func(id string, args ...interface{}) string) {
+	l.catalogue = messageFunc(message)
+}
+
+// String returns the comma separated descriptions.
+func (l *constraintList) String() string {
+	descriptions := make([]string, len(l.constraints))
+	for i, c := range l.constraints {
+		descriptions[i] = c.Format(l.catalogue)
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// messageFunc converts a message function to a message catalogue.
+type messageFunc func(id string, args ...interface{}) string
+
+// Message returns the text of the message with the specified identifier, formatted using the arguments.
+func (m messageFunc) Message(id string, args ...interface{}) string {
+	return m(id, args...)
+}
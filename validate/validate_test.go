@@ -0,0 +1,201 @@
+package validate_test
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/xitonix/flags/core"
+	"github.com/xitonix/flags/validate"
+)
+
+func TestValidators(t *testing.T) {
+	even := validate.Custom("must be even", func(value interface{}) error {
+		if value.(int)%2 != 0 {
+			return errors.New("the value must be even")
+		}
+		return nil
+	})
+	testCases := []struct {
+		title               string
+		validator           core.Validator
+		value               interface{}
+		expectedError       string
+		expectedDescription string
+	}{
+		{
+			title:               "value above the inclusive minimum",
+			validator:           validate.Min(1),
+			value:               1,
+			expectedDescription: "range: >= 1",
+		},
+		{
+			title:               "value below the minimum",
+			validator:           validate.Min(1),
+			value:               0,
+			expectedError:       "the expected range is >= 1",
+			expectedDescription: "range: >= 1",
+		},
+		{
+			title:               "value equal to the exclusive minimum",
+			validator:           validate.Min(0.5, core.Exclusive),
+			value:               0.5,
+			expectedError:       "the expected range is > 0.5",
+			expectedDescription: "range: > 0.5",
+		},
+		{
+			title:               "value above the maximum",
+			validator:           validate.Max(uint8(10)),
+			value:               11,
+			expectedError:       "the expected range is <= 10",
+			expectedDescription: "range: <= 10",
+		},
+		{
+			title:               "negative value compared with an unsigned maximum",
+			validator:           validate.Max(uint(10)),
+			value:               -1,
+			expectedDescription: "range: <= 10",
+		},
+		{
+			title:               "integer value compared with a float range",
+			validator:           validate.Between(0.5, 2.5),
+			value:               int64(2),
+			expectedDescription: "range: [0.5, 2.5]",
+		},
+		{
+			title:               "duration within the range",
+			validator:           validate.Between(time.Second, time.Minute),
+			value:               10 * time.Second,
+			expectedDescription: "range: [1s, 1m0s]",
+		},
+		{
+			title:               "duration outside of the exclusive range",
+			validator:           validate.Between(time.Second, time.Minute, core.Exclusive),
+			value:               time.Minute,
+			expectedError:       "the expected range is (1s, 1m0s)",
+			expectedDescription: "range: (1s, 1m0s)",
+		},
+		{
+			title:               "string within the range",
+			validator:           validate.Between("a", "m"),
+			value:               "go",
+			expectedDescription: "range: [a, m]",
+		},
+		{
+			title:               "incomparable value",
+			validator:           validate.Min(1),
+			value:               "1",
+			expectedError:       "the value cannot be compared with 1",
+			expectedDescription: "range: >= 1",
+		},
+		{
+			title:               "value matching the pattern",
+			validator:           validate.Pattern(regexp.MustCompile(`^10\.`)),
+			value:               "10.0.0.1",
+			expectedDescription: `pattern: ^10\.`,
+		},
+		{
+			title:               "value not matching the pattern",
+			validator:           validate.Pattern(regexp.MustCompile(`^10\.`)),
+			value:               "192.168.1.1",
+			expectedError:       `the value must match the ^10\. pattern`,
+			expectedDescription: `pattern: ^10\.`,
+		},
+		{
+			title:               "numeric value within the list",
+			validator:           validate.OneOf(80, 443),
+			value:               uint16(443),
+			expectedDescription: "one of: 80, 443",
+		},
+		{
+			title:               "string value outside of the list",
+			validator:           validate.OneOf("json", "yaml"),
+			value:               "xml",
+			expectedError:       "the expected values are json, yaml",
+			expectedDescription: "one of: json, yaml",
+		},
+		{
+			title:               "custom validator",
+			validator:           even,
+			value:               3,
+			expectedError:       "the value must be even",
+			expectedDescription: "must be even",
+		},
+		{
+			title:               "all the validators pass",
+			validator:           validate.All(validate.Min(1), even),
+			value:               2,
+			expectedDescription: "all of (range: >= 1, must be even)",
+		},
+		{
+			title:               "the first failure of all the validators",
+			validator:           validate.All(validate.Min(1), even),
+			value:               -1,
+			expectedError:       "the expected range is >= 1",
+			expectedDescription: "all of (range: >= 1, must be even)",
+		},
+		{
+			title:               "one of the alternatives passes",
+			validator:           validate.Any(validate.Max(0), validate.Min(10)),
+			value:               10,
+			expectedDescription: "any of (range: <= 0, range: >= 10)",
+		},
+		{
+			title:               "none of the alternatives pass",
+			validator:           validate.Any(validate.Max(0), validate.Min(10)),
+			value:               5,
+			expectedError:       "the value must satisfy at least one of range: <= 0, range: >= 10",
+			expectedDescription: "any of (range: <= 0, range: >= 10)",
+		},
+		{
+			title:               "negated validator passes",
+			validator:           validate.Not(validate.OneOf(22, 25)),
+			value:               8080,
+			expectedDescription: "not (one of: 22, 25)",
+		},
+		{
+			title:               "negated validator fails",
+			validator:           validate.Not(validate.OneOf(22, 25)),
+			value:               22,
+			expectedError:       "the value must not satisfy one of: 22, 25",
+			expectedDescription: "not (one of: 22, 25)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.title, func(t *testing.T) {
+			err := tc.validator.Validate(tc.value)
+			if tc.expectedError == "" && err != nil {
+				t.Errorf("Expected no error, but received: %s", err)
+			}
+			if tc.expectedError != "" && (err == nil || err.Error() != tc.expectedError) {
+				t.Errorf("Expected error: '%s', Actual: '%v'", tc.expectedError, err)
+			}
+			if actual := tc.validator.Describe().String(); actual != tc.expectedDescription {
+				t.Errorf("Expected description: '%s', Actual: '%s'", tc.expectedDescription, actual)
+			}
+		})
+	}
+}
+
+func TestValidators_Localise(t *testing.T) {
+	catalogue := core.MessageMap{
+		core.MsgValidateNot: "der Wert darf nicht %s erfüllen",
+		core.MsgOneOf:       "eines von: %s",
+		core.MsgNot:         "nicht (%s)",
+	}
+	v := validate.Not(validate.OneOf(22))
+	if actual := v.Describe().Format(catalogue); actual != "nicht (eines von: 22)" {
+		t.Errorf("Expected: 'nicht (eines von: 22)', Actual: '%s'", actual)
+	}
+	err := v.Validate(22)
+	le, ok := err.(core.LocalisableError)
+	if !ok {
+		t.Fatalf("Expected a localisable error, Actual: %T", err)
+	}
+	le.Localise(catalogue.Message)
+	if expected := "der Wert darf nicht eines von: 22 erfüllen"; le.Error() != expected {
+		t.Errorf("Expected: '%s', Actual: '%s'", expected, le.Error())
+	}
+}
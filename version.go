@@ -0,0 +1,55 @@
+package flags
+
+import (
+	"runtime"
+	"text/template"
+
+	"github.com/xitonix/flags/internal"
+)
+
+// VersionInfo holds the version information of the running tool.
+//
+// The version information will be printed using the bucket's version template when
+// the built-in version flag (--version, -V) is provided.
+type VersionInfo struct {
+	// Program is the name of the running tool.
+	Program string
+	// Version is the version of the running tool.
+	Version string
+	// Commit is the VCS revision the tool has been built from (if available).
+	Commit string
+	// Time is the time of the VCS revision (if available).
+	Time string
+	// Dirty is true if the working tree had local modifications at build time.
+	Dirty bool
+	// GoVersion is the version of the Go toolchain which has been used to build the tool.
+	GoVersion string
+}
+
+// newVersionInfo creates a new version information object using the build information embedded in the
+// running binary (if available). The version string will override the module version if it's not empty.
+func newVersionInfo(version string, useBuildInfo bool) *VersionInfo {
+	info := &VersionInfo{
+		GoVersion: runtime.Version(),
+	}
+	if useBuildInfo {
+		readBuildInfo(info)
+	}
+	if !internal.IsEmpty(version) {
+		info.Version = version
+	}
+	return info
+}
+
+func (b *Bucket) printVersion() error {
+	tmpl, err := template.New("version").Parse(b.opts.VersionTemplate)
+	if err != nil {
+		return err
+	}
+	info := *b.version
+	info.Program = b.opts.ProgramName
+	if err := tmpl.Execute(b.opts.HelpWriter, info); err != nil {
+		return err
+	}
+	return b.opts.HelpWriter.Close()
+}